@@ -0,0 +1,70 @@
+// Package admin - api of admin.* methods to manage Enterprise Grid organizations. The methods require a user token of
+// an org admin or owner with admin.* scopes
+package admin
+
+import (
+	"context"
+
+	"github.com/kryabinin/go-slack"
+)
+
+type (
+	// Client provides api of admin.* methods
+	Client interface {
+		Users
+		Conversations
+		Teams
+		Apps
+	}
+
+	client struct {
+		api slack.Client
+	}
+
+	params map[string]interface{}
+
+	empty struct{}
+)
+
+// NewClient is admin client constructor, requests are sent by the api client
+func NewClient(api slack.Client) Client {
+	return &client{api: api}
+}
+
+func call[Resp any](ctx context.Context, c *client, method string, p params, opts []Option) (Resp, error) {
+	for _, opt := range opts {
+		opt.apply(p)
+	}
+
+	return slack.Call[params, Resp](ctx, c.api, method, p)
+}
+
+// iterate gets pages with the list function passing the cursor of the previous page and passes their items to the
+// function until there are no more pages or the function returns an error
+func iterate[Page, Item any](
+	opts []Option,
+	list func(opts ...Option) (Page, error),
+	items func(page Page) ([]Item, string),
+	fn func(item Item) error,
+) error {
+	pageOpts := opts
+	for {
+		page, err := list(pageOpts...)
+		if err != nil {
+			return err
+		}
+
+		pageItems, cursor := items(page)
+		for _, item := range pageItems {
+			if err = fn(item); err != nil {
+				return err
+			}
+		}
+
+		if len(cursor) == 0 {
+			return nil
+		}
+
+		pageOpts = append(opts[:len(opts):len(opts)], Cursor(cursor))
+	}
+}
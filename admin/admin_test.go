@@ -0,0 +1,259 @@
+package admin_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kryabinin/go-slack/admin"
+	"github.com/kryabinin/go-slack/slackfake"
+)
+
+func fakeApi(responses map[string]string) *slackfake.Client {
+	respond := func(path string) ([]byte, error) {
+		if resp, ok := responses[path]; ok {
+			return []byte(resp), nil
+		}
+
+		return []byte(`{"ok":true}`), nil
+	}
+
+	return &slackfake.Client{
+		SendRequestFunc: func(_ context.Context, _ string, path string, _ []byte) ([]byte, error) {
+			return respond(path)
+		},
+		SendFormRequestFunc: func(_ context.Context, path string, _ []byte) ([]byte, error) {
+			return respond(path)
+		},
+	}
+}
+
+// pagedApi responds with the pages in order regardless of the method
+func pagedApi(pages ...string) *slackfake.Client {
+	var n int
+	next := func() ([]byte, error) {
+		page := pages[n]
+		n++
+
+		return []byte(page), nil
+	}
+
+	return &slackfake.Client{
+		SendRequestFunc: func(context.Context, string, string, []byte) ([]byte, error) {
+			return next()
+		},
+		SendFormRequestFunc: func(context.Context, string, []byte) ([]byte, error) {
+			return next()
+		},
+	}
+}
+
+func TestClient_Users(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("list users", func(t *testing.T) {
+		api := fakeApi(map[string]string{"admin.users.list": `{"ok":true,` +
+			`"users":[{"id":"U1","email":"jane@example.com","is_admin":true,"workspaces":["T1"]}],` +
+			`"response_metadata":{"next_cursor":"abc"}}`})
+
+		users, err := admin.NewClient(api).ListUsers(ctx, "T1", admin.Cursor("xyz"), admin.Limit(1))
+		assert.NoError(t, err)
+		assert.Equal(t, []admin.User{{ID: "U1", Email: "jane@example.com", IsAdmin: true, Workspaces: []string{"T1"}}},
+			users.Users)
+		assert.Equal(t, "abc", users.ResponseMetadata.NextCursor)
+
+		form, err := url.ParseQuery(string(api.SendFormRequestCalls()[0].Data))
+		assert.NoError(t, err)
+		assert.Equal(t, url.Values{"team_id": {"T1"}, "cursor": {"xyz"}, "limit": {"1"}}, form)
+	})
+
+	t.Run("iterate users", func(t *testing.T) {
+		api := pagedApi(
+			`{"ok":true,"users":[{"id":"U1"},{"id":"U2"}],"response_metadata":{"next_cursor":"abc"}}`,
+			`{"ok":true,"users":[{"id":"U3"}],"response_metadata":{"next_cursor":""}}`,
+		)
+
+		var ids []string
+		err := admin.NewClient(api).IterateUsers(ctx, "T1", func(user admin.User) error {
+			ids = append(ids, user.ID)
+			return nil
+		}, admin.Limit(2))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"U1", "U2", "U3"}, ids)
+
+		calls := api.SendFormRequestCalls()
+		assert.Len(t, calls, 2)
+
+		form, err := url.ParseQuery(string(calls[1].Data))
+		assert.NoError(t, err)
+		assert.Equal(t, url.Values{"team_id": {"T1"}, "cursor": {"abc"}, "limit": {"2"}}, form)
+	})
+
+	t.Run("iteration stops on error", func(t *testing.T) {
+		api := pagedApi(`{"ok":true,"users":[{"id":"U1"},{"id":"U2"}],"response_metadata":{"next_cursor":"abc"}}`)
+		expErr := errors.New("test error")
+
+		err := admin.NewClient(api).IterateUsers(ctx, "T1", func(user admin.User) error {
+			return expErr
+		})
+		assert.Equal(t, expErr, err)
+		assert.Len(t, api.SendFormRequestCalls(), 1)
+	})
+
+	t.Run("invite user", func(t *testing.T) {
+		api := fakeApi(nil)
+
+		err := admin.NewClient(api).InviteUser(ctx, "T1", "john@example.com", []string{"C1", "C2"},
+			admin.Restricted(), admin.RealName("John"))
+		assert.NoError(t, err)
+
+		calls := api.SendRequestCalls()
+		assert.Equal(t, "admin.users.invite", calls[0].Path)
+		assert.JSONEq(t, `{"team_id":"T1","email":"john@example.com","channel_ids":"C1,C2",`+
+			`"is_restricted":true,"real_name":"John"}`, string(calls[0].Data))
+	})
+
+	t.Run("reset sessions", func(t *testing.T) {
+		api := fakeApi(nil)
+
+		assert.NoError(t, admin.NewClient(api).ResetSessions(ctx, "U1", admin.MobileOnly()))
+		assert.Equal(t, "admin.users.session.reset", api.SendRequestCalls()[0].Path)
+		assert.JSONEq(t, `{"user_id":"U1","mobile_only":true}`, string(api.SendRequestCalls()[0].Data))
+	})
+
+	t.Run("slack respond with error", func(t *testing.T) {
+		api := fakeApi(map[string]string{"admin.users.setAdmin": `{"ok":false,"error":"user_not_found"}`})
+
+		err := admin.NewClient(api).SetAdmin(ctx, "T1", "U1")
+		assert.Error(t, err)
+		assert.Equal(t, "slack respond with error: user_not_found", err.Error())
+	})
+}
+
+func TestClient_Conversations(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("search conversations", func(t *testing.T) {
+		api := fakeApi(map[string]string{"admin.conversations.search": `{"ok":true,` +
+			`"conversations":[{"id":"C1","name":"general","member_count":3,"connected_team_ids":["T1"]}],` +
+			`"next_cursor":"abc"}`})
+
+		conversations, err := admin.NewClient(api).SearchConversations(ctx, admin.Query("gen"),
+			admin.TeamIDs("T1", "T2"), admin.Sort("name", "asc"))
+		assert.NoError(t, err)
+		assert.Equal(t, []admin.Conversation{{ID: "C1", Name: "general", MemberCount: 3,
+			ConnectedTeamIDs: []string{"T1"}}}, conversations.Conversations)
+		assert.Equal(t, "abc", conversations.ResponseMetadata.NextCursor)
+		assert.JSONEq(t, `{"query":"gen","team_ids":"T1,T2","sort":"name","sort_dir":"asc"}`,
+			string(api.SendRequestCalls()[0].Data))
+	})
+
+	t.Run("iterate conversations", func(t *testing.T) {
+		api := pagedApi(
+			`{"ok":true,"conversations":[{"id":"C1"}],"next_cursor":"abc"}`,
+			`{"ok":true,"conversations":[{"id":"C2"}],"next_cursor":""}`,
+		)
+
+		var ids []string
+		err := admin.NewClient(api).IterateConversations(ctx, func(conversation admin.Conversation) error {
+			ids = append(ids, conversation.ID)
+			return nil
+		}, admin.Query("gen"))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"C1", "C2"}, ids)
+
+		calls := api.SendRequestCalls()
+		assert.Len(t, calls, 2)
+		assert.JSONEq(t, `{"query":"gen"}`, string(calls[0].Data))
+		assert.JSONEq(t, `{"query":"gen","cursor":"abc"}`, string(calls[1].Data))
+	})
+
+	t.Run("create conversation", func(t *testing.T) {
+		api := fakeApi(map[string]string{"admin.conversations.create": `{"ok":true,"channel_id":"C9"}`})
+
+		channelID, err := admin.NewClient(api).CreateConversation(ctx, "ops", true, admin.OrgWide())
+		assert.NoError(t, err)
+		assert.Equal(t, "C9", channelID)
+		assert.JSONEq(t, `{"name":"ops","is_private":true,"org_wide":true}`, string(api.SendRequestCalls()[0].Data))
+	})
+
+	t.Run("set teams", func(t *testing.T) {
+		api := fakeApi(nil)
+
+		err := admin.NewClient(api).SetConversationTeams(ctx, "C1", []string{"T1", "T2"}, admin.TeamID("T1"))
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"channel_id":"C1","target_team_ids":"T1,T2","team_id":"T1"}`,
+			string(api.SendRequestCalls()[0].Data))
+	})
+}
+
+func TestClient_Teams(t *testing.T) {
+	ctx := context.Background()
+
+	api := fakeApi(map[string]string{
+		"admin.teams.list": `{"ok":true,"teams":[{"id":"T1","name":"Acme","discoverability":"open",` +
+			`"primary_owner":{"user_id":"U1"}}],"response_metadata":{"next_cursor":""}}`,
+		"admin.teams.create":        `{"ok":true,"team":"T2"}`,
+		"admin.teams.settings.info": `{"ok":true,"team":{"id":"T1","name":"Acme","default_channels":["C1"]}}`,
+	})
+	c := admin.NewClient(api)
+
+	teams, err := c.ListTeams(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "open", teams.Teams[0].Discoverability)
+	assert.Equal(t, "U1", teams.Teams[0].PrimaryOwner.UserID)
+
+	teamID, err := c.CreateTeam(ctx, "acme-labs", "Acme Labs", admin.Discoverability("invite_only"))
+	assert.NoError(t, err)
+	assert.Equal(t, "T2", teamID)
+
+	settings, err := c.GetTeamSettings(ctx, "T1")
+	assert.NoError(t, err)
+	assert.Equal(t, "Acme", settings.Name)
+	assert.Equal(t, []string{"C1"}, settings.DefaultChannels)
+
+	assert.NoError(t, c.SetTeamDefaultChannels(ctx, "T1", []string{"C1", "C2"}))
+
+	// list and info methods are sent as forms
+	calls := api.SendRequestCalls()
+	assert.JSONEq(t, `{"team_domain":"acme-labs","team_name":"Acme Labs","team_discoverability":"invite_only"}`,
+		string(calls[0].Data))
+	assert.Equal(t, "admin.teams.settings.setDefaultChannels", calls[1].Path)
+	assert.JSONEq(t, `{"team_id":"T1","channel_ids":"C1,C2"}`, string(calls[1].Data))
+}
+
+func TestClient_Apps(t *testing.T) {
+	ctx := context.Background()
+
+	api := fakeApi(map[string]string{
+		"admin.apps.requests.list": `{"ok":true,"app_requests":[{"id":"R1","app":{"id":"A1","name":"Bot"},` +
+			`"user":{"id":"U1"},"scopes":[{"name":"chat:write","token_type":"bot"}]}],` +
+			`"response_metadata":{"next_cursor":"abc"}}`,
+		"admin.apps.approved.list": `{"ok":true,"approved_apps":[{"app":{"id":"A2"},` +
+			`"last_resolved_by":{"actor_id":"U2","actor_type":"user"}}]}`,
+	})
+	c := admin.NewClient(api)
+
+	requests, err := c.ListAppRequests(ctx, admin.TeamID("T1"))
+	assert.NoError(t, err)
+	assert.Equal(t, "A1", requests.AppRequests[0].App.ID)
+	assert.Equal(t, "chat:write", requests.AppRequests[0].Scopes[0].Name)
+	assert.Equal(t, "abc", requests.ResponseMetadata.NextCursor)
+
+	assert.NoError(t, c.ApproveAppRequest(ctx, "R1", admin.TeamID("T1")))
+	assert.NoError(t, c.RestrictApp(ctx, "A3", admin.EnterpriseID("E1")))
+
+	approved, err := c.ListApprovedApps(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "A2", approved.Apps[0].App.ID)
+	assert.Equal(t, "U2", approved.Apps[0].LastResolvedBy.ActorID)
+
+	calls := api.SendRequestCalls()
+	assert.JSONEq(t, `{"request_id":"R1","team_id":"T1"}`, string(calls[0].Data))
+	assert.Equal(t, "admin.apps.restrict", calls[1].Path)
+	assert.JSONEq(t, `{"app_id":"A3","enterprise_id":"E1"}`, string(calls[1].Data))
+	assert.Len(t, api.SendFormRequestCalls(), 2)
+}
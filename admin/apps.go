@@ -0,0 +1,251 @@
+// Package admin - apps
+package admin
+
+import (
+	"context"
+
+	"github.com/kryabinin/go-slack"
+)
+
+type (
+	// Apps provides api to manage app approvals of the organization. Use TeamID or EnterpriseID options to set
+	// where the apps are approved or restricted
+	Apps interface {
+		// ListAppRequests lists pending requests to install apps, use Cursor and Limit options to paginate
+		ListAppRequests(ctx context.Context, opts ...Option) (AppRequestsList, error)
+
+		// IterateAppRequests passes every pending request to install an app to the function until there are no more
+		// pages or the function returns an error
+		IterateAppRequests(ctx context.Context, fn func(request AppRequest) error, opts ...Option) error
+
+		// ApproveApp approves an app for installation
+		ApproveApp(ctx context.Context, appID string, opts ...Option) error
+
+		// ApproveAppRequest approves a request to install an app
+		ApproveAppRequest(ctx context.Context, requestID string, opts ...Option) error
+
+		// RestrictApp restricts an app for installation
+		RestrictApp(ctx context.Context, appID string, opts ...Option) error
+
+		// RestrictAppRequest denies a request to install an app
+		RestrictAppRequest(ctx context.Context, requestID string, opts ...Option) error
+
+		// ListApprovedApps lists approved apps, use Cursor and Limit options to paginate
+		ListApprovedApps(ctx context.Context, opts ...Option) (AppsList, error)
+
+		// IterateApprovedApps passes every approved app to the function until there are no more pages or the function
+		// returns an error
+		IterateApprovedApps(ctx context.Context, fn func(app AppApproval) error, opts ...Option) error
+
+		// ListRestrictedApps lists restricted apps, use Cursor and Limit options to paginate
+		ListRestrictedApps(ctx context.Context, opts ...Option) (AppsList, error)
+
+		// IterateRestrictedApps passes every restricted app to the function until there are no more pages or the
+		// function returns an error
+		IterateRestrictedApps(ctx context.Context, fn func(app AppApproval) error, opts ...Option) error
+	}
+
+	// App app of an approval
+	App struct {
+		// ID app identifier
+		ID string `json:"id"`
+
+		// Name app name
+		Name string `json:"name"`
+
+		// Description app description
+		Description string `json:"description"`
+
+		// HelpUrl url of the app help page
+		HelpUrl string `json:"help_url"`
+
+		// PrivacyPolicyUrl url of the app privacy policy
+		PrivacyPolicyUrl string `json:"privacy_policy_url"`
+
+		// IsAppDirectoryApproved the app is listed in the app directory
+		IsAppDirectoryApproved bool `json:"is_app_directory_approved"`
+
+		// IsInternal the app is built by the organization
+		IsInternal bool `json:"is_internal"`
+	}
+
+	// Scope permission requested by an app
+	Scope struct {
+		// Name scope name, e.g. chat:write
+		Name string `json:"name"`
+
+		// Description scope description
+		Description string `json:"description"`
+
+		// IsSensitive the scope gives access to sensitive data
+		IsSensitive bool `json:"is_sensitive"`
+
+		// TokenType type of the token the scope is requested for: bot or user
+		TokenType string `json:"token_type"`
+	}
+
+	// AppRequest request of a user to install an app
+	AppRequest struct {
+		// ID request identifier
+		ID string `json:"id"`
+
+		// App requested app
+		App App `json:"app"`
+
+		// User user requested the app
+		User struct {
+			// ID user identifier
+			ID string `json:"id"`
+
+			// Name user's name
+			Name string `json:"name"`
+
+			// Email user's email
+			Email string `json:"email"`
+		} `json:"user"`
+
+		// Team workspace to install the app to
+		Team struct {
+			// ID workspace identifier
+			ID string `json:"id"`
+
+			// Name workspace name
+			Name string `json:"name"`
+
+			// Domain workspace domain
+			Domain string `json:"domain"`
+		} `json:"team"`
+
+		// Scopes requested scopes
+		Scopes []Scope `json:"scopes"`
+
+		// Message message of the user to admins
+		Message string `json:"message"`
+
+		// DateCreated a unix timestamp when the request was created
+		DateCreated int64 `json:"date_created"`
+	}
+
+	// AppRequestsList page of app requests
+	AppRequestsList struct {
+		// AppRequests list of app requests
+		AppRequests []AppRequest `json:"app_requests"`
+
+		// ResponseMetadata pagination information
+		ResponseMetadata slack.ResponseMetadata `json:"response_metadata"`
+	}
+
+	// AppApproval approved or restricted app
+	AppApproval struct {
+		// App the app
+		App App `json:"app"`
+
+		// Scopes approved or restricted scopes
+		Scopes []Scope `json:"scopes"`
+
+		// DateUpdated a unix timestamp when the app was approved or restricted
+		DateUpdated int64 `json:"date_updated"`
+
+		// LastResolvedBy admin approved or restricted the app
+		LastResolvedBy struct {
+			// ActorID identifier of the admin
+			ActorID string `json:"actor_id"`
+
+			// ActorType type of the actor, e.g. user
+			ActorType string `json:"actor_type"`
+		} `json:"last_resolved_by"`
+	}
+
+	// AppsList page of approved or restricted apps
+	AppsList struct {
+		// Apps list of apps
+		Apps []AppApproval `json:"apps"`
+
+		// ResponseMetadata pagination information
+		ResponseMetadata slack.ResponseMetadata `json:"response_metadata"`
+	}
+
+	approvedAppsResponse struct {
+		Apps             []AppApproval          `json:"approved_apps"`
+		ResponseMetadata slack.ResponseMetadata `json:"response_metadata"`
+	}
+
+	restrictedAppsResponse struct {
+		Apps             []AppApproval          `json:"restricted_apps"`
+		ResponseMetadata slack.ResponseMetadata `json:"response_metadata"`
+	}
+)
+
+// ListAppRequests implementation
+func (c *client) ListAppRequests(ctx context.Context, opts ...Option) (AppRequestsList, error) {
+	return call[AppRequestsList](ctx, c, "admin.apps.requests.list", params{}, opts)
+}
+
+// IterateAppRequests implementation
+func (c *client) IterateAppRequests(ctx context.Context, fn func(request AppRequest) error, opts ...Option) error {
+	return iterate(opts, func(opts ...Option) (AppRequestsList, error) {
+		return c.ListAppRequests(ctx, opts...)
+	}, func(page AppRequestsList) ([]AppRequest, string) {
+		return page.AppRequests, page.ResponseMetadata.NextCursor
+	}, fn)
+}
+
+// ApproveApp implementation
+func (c *client) ApproveApp(ctx context.Context, appID string, opts ...Option) error {
+	_, err := call[empty](ctx, c, "admin.apps.approve", params{"app_id": appID}, opts)
+
+	return err
+}
+
+// ApproveAppRequest implementation
+func (c *client) ApproveAppRequest(ctx context.Context, requestID string, opts ...Option) error {
+	_, err := call[empty](ctx, c, "admin.apps.approve", params{"request_id": requestID}, opts)
+
+	return err
+}
+
+// RestrictApp implementation
+func (c *client) RestrictApp(ctx context.Context, appID string, opts ...Option) error {
+	_, err := call[empty](ctx, c, "admin.apps.restrict", params{"app_id": appID}, opts)
+
+	return err
+}
+
+// RestrictAppRequest implementation
+func (c *client) RestrictAppRequest(ctx context.Context, requestID string, opts ...Option) error {
+	_, err := call[empty](ctx, c, "admin.apps.restrict", params{"request_id": requestID}, opts)
+
+	return err
+}
+
+// ListApprovedApps implementation
+func (c *client) ListApprovedApps(ctx context.Context, opts ...Option) (AppsList, error) {
+	resp, err := call[approvedAppsResponse](ctx, c, "admin.apps.approved.list", params{}, opts)
+
+	return AppsList(resp), err
+}
+
+// IterateApprovedApps implementation
+func (c *client) IterateApprovedApps(ctx context.Context, fn func(app AppApproval) error, opts ...Option) error {
+	return iterate(opts, func(opts ...Option) (AppsList, error) {
+		return c.ListApprovedApps(ctx, opts...)
+	}, appsPage, fn)
+}
+
+// ListRestrictedApps implementation
+func (c *client) ListRestrictedApps(ctx context.Context, opts ...Option) (AppsList, error) {
+	resp, err := call[restrictedAppsResponse](ctx, c, "admin.apps.restricted.list", params{}, opts)
+
+	return AppsList(resp), err
+}
+
+// IterateRestrictedApps implementation
+func (c *client) IterateRestrictedApps(ctx context.Context, fn func(app AppApproval) error, opts ...Option) error {
+	return iterate(opts, func(opts ...Option) (AppsList, error) {
+		return c.ListRestrictedApps(ctx, opts...)
+	}, appsPage, fn)
+}
+
+func appsPage(page AppsList) ([]AppApproval, string) {
+	return page.Apps, page.ResponseMetadata.NextCursor
+}
@@ -0,0 +1,146 @@
+// Package admin - conversations
+package admin
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kryabinin/go-slack"
+)
+
+type (
+	// Conversations provides api to manage conversations of the organization
+	Conversations interface {
+		// SearchConversations searches conversations across the organization, use Query, TeamIDs, ChannelTypes and
+		// Sort options to filter and Cursor and Limit options to paginate
+		SearchConversations(ctx context.Context, opts ...Option) (ConversationsList, error)
+
+		// IterateConversations passes every conversation found with the options to the function until there are no
+		// more pages or the function returns an error
+		IterateConversations(ctx context.Context, fn func(conversation Conversation) error, opts ...Option) error
+
+		// CreateConversation creates a public or private channel, returns its id. Use TeamID option to create it
+		// in a workspace or OrgWide option to make it available to the whole organization
+		CreateConversation(ctx context.Context, name string, isPrivate bool, opts ...Option) (string, error)
+
+		// ArchiveConversation archives a channel
+		ArchiveConversation(ctx context.Context, channelID string) error
+
+		// SetConversationTeams sets workspaces a channel is shared to, use TeamID option to set the workspace
+		// the channel belongs to
+		SetConversationTeams(ctx context.Context, channelID string, teamIDs []string, opts ...Option) error
+
+		// ConvertToPrivate converts a public channel to a private one
+		ConvertToPrivate(ctx context.Context, channelID string) error
+	}
+
+	// Conversation channel of the organization
+	Conversation struct {
+		// ID channel identifier
+		ID string `json:"id"`
+
+		// Name channel name
+		Name string `json:"name"`
+
+		// Purpose channel purpose
+		Purpose string `json:"purpose"`
+
+		// MemberCount number of channel members
+		MemberCount int `json:"member_count"`
+
+		// Created a unix timestamp when the channel was created
+		Created int64 `json:"created"`
+
+		// CreatorID user created the channel
+		CreatorID string `json:"creator_id"`
+
+		// IsPrivate the channel is private
+		IsPrivate bool `json:"is_private"`
+
+		// IsArchived the channel is archived
+		IsArchived bool `json:"is_archived"`
+
+		// IsOrgShared the channel is shared to the whole organization
+		IsOrgShared bool `json:"is_org_shared"`
+
+		// ConnectedTeamIDs workspaces the channel is shared to
+		ConnectedTeamIDs []string `json:"connected_team_ids"`
+
+		// InternalTeamIDs workspaces of the organization the channel belongs to
+		InternalTeamIDs []string `json:"internal_team_ids"`
+	}
+
+	// ConversationsList page of conversations
+	ConversationsList struct {
+		// Conversations list of conversations
+		Conversations []Conversation `json:"conversations"`
+
+		// ResponseMetadata pagination information
+		ResponseMetadata slack.ResponseMetadata `json:"response_metadata"`
+	}
+
+	conversationsSearchResponse struct {
+		Conversations []Conversation `json:"conversations"`
+		NextCursor    string         `json:"next_cursor"`
+	}
+
+	conversationCreateResponse struct {
+		ChannelID string `json:"channel_id"`
+	}
+)
+
+// SearchConversations implementation
+func (c *client) SearchConversations(ctx context.Context, opts ...Option) (ConversationsList, error) {
+	resp, err := call[conversationsSearchResponse](ctx, c, "admin.conversations.search", params{}, opts)
+	if err != nil {
+		return ConversationsList{}, err
+	}
+
+	return ConversationsList{
+		Conversations:    resp.Conversations,
+		ResponseMetadata: slack.ResponseMetadata{NextCursor: resp.NextCursor},
+	}, nil
+}
+
+// IterateConversations implementation
+func (c *client) IterateConversations(
+	ctx context.Context,
+	fn func(conversation Conversation) error,
+	opts ...Option,
+) error {
+	return iterate(opts, func(opts ...Option) (ConversationsList, error) {
+		return c.SearchConversations(ctx, opts...)
+	}, func(page ConversationsList) ([]Conversation, string) {
+		return page.Conversations, page.ResponseMetadata.NextCursor
+	}, fn)
+}
+
+// CreateConversation implementation
+func (c *client) CreateConversation(ctx context.Context, name string, isPrivate bool, opts ...Option) (string, error) {
+	resp, err := call[conversationCreateResponse](ctx, c, "admin.conversations.create",
+		params{"name": name, "is_private": isPrivate}, opts)
+
+	return resp.ChannelID, err
+}
+
+// ArchiveConversation implementation
+func (c *client) ArchiveConversation(ctx context.Context, channelID string) error {
+	_, err := call[empty](ctx, c, "admin.conversations.archive", params{"channel_id": channelID}, nil)
+
+	return err
+}
+
+// SetConversationTeams implementation
+func (c *client) SetConversationTeams(ctx context.Context, channelID string, teamIDs []string, opts ...Option) error {
+	_, err := call[empty](ctx, c, "admin.conversations.setTeams",
+		params{"channel_id": channelID, "target_team_ids": strings.Join(teamIDs, ",")}, opts)
+
+	return err
+}
+
+// ConvertToPrivate implementation
+func (c *client) ConvertToPrivate(ctx context.Context, channelID string) error {
+	_, err := call[empty](ctx, c, "admin.conversations.convertToPrivate", params{"channel_id": channelID}, nil)
+
+	return err
+}
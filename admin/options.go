@@ -0,0 +1,138 @@
+// Package admin - options
+package admin
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+type (
+	// Option to apply optional parameters to admin requests
+	Option interface {
+		apply(p params)
+	}
+
+	param struct {
+		key string
+		val interface{}
+	}
+
+	multiParam []*param
+)
+
+// Cursor sets a cursor returned in the previous page
+func Cursor(cursor string) Option {
+	return &param{key: "cursor", val: cursor}
+}
+
+// Limit sets the maximum number of items to return
+func Limit(limit int) Option {
+	return &param{key: "limit", val: limit}
+}
+
+// TeamID sets workspace of the request
+func TeamID(teamID string) Option {
+	return &param{key: "team_id", val: teamID}
+}
+
+// EnterpriseID sets organization of the request, e.g. to approve an app for the whole organization
+func EnterpriseID(enterpriseID string) Option {
+	return &param{key: "enterprise_id", val: enterpriseID}
+}
+
+// ChannelIDs sets channels to add an invited or assigned user to
+func ChannelIDs(channelIDs ...string) Option {
+	return &param{key: "channel_ids", val: strings.Join(channelIDs, ",")}
+}
+
+// Restricted makes an invited or assigned user a multi-channel guest
+func Restricted() Option {
+	return &param{key: "is_restricted", val: true}
+}
+
+// UltraRestricted makes an invited or assigned user a single-channel guest
+func UltraRestricted() Option {
+	return &param{key: "is_ultra_restricted", val: true}
+}
+
+// CustomMessage sets message of an invitation email
+func CustomMessage(message string) Option {
+	return &param{key: "custom_message", val: message}
+}
+
+// RealName sets full name of an invited user
+func RealName(name string) Option {
+	return &param{key: "real_name", val: name}
+}
+
+// GuestExpiration sets when an invited guest account is deactivated
+func GuestExpiration(expiration time.Time) Option {
+	return &param{key: "guest_expiration_ts", val: strconv.FormatInt(expiration.Unix(), 10)}
+}
+
+// Resend allows to resend an invitation to the same email
+func Resend() Option {
+	return &param{key: "resend", val: true}
+}
+
+// MobileOnly resets only mobile sessions of a user
+func MobileOnly() Option {
+	return &param{key: "mobile_only", val: true}
+}
+
+// WebOnly resets only web sessions of a user
+func WebOnly() Option {
+	return &param{key: "web_only", val: true}
+}
+
+// Query filters conversations by name
+func Query(query string) Option {
+	return &param{key: "query", val: query}
+}
+
+// TeamIDs filters conversations by workspaces
+func TeamIDs(teamIDs ...string) Option {
+	return &param{key: "team_ids", val: strings.Join(teamIDs, ",")}
+}
+
+// ChannelTypes filters conversations by types: private, archived, exclude_archived, private_exclude,
+// org_wide, multi_workspace and others
+func ChannelTypes(types ...string) Option {
+	return &param{key: "search_channel_types", val: strings.Join(types, ",")}
+}
+
+// Sort sorts found conversations by relevant, name, member_count or created in asc or desc direction
+func Sort(sort, dir string) Option {
+	return multiParam{&param{key: "sort", val: sort}, &param{key: "sort_dir", val: dir}}
+}
+
+// Description sets description of a created conversation
+func Description(description string) Option {
+	return &param{key: "description", val: description}
+}
+
+// TeamDescription sets description of a created workspace
+func TeamDescription(description string) Option {
+	return &param{key: "team_description", val: description}
+}
+
+// OrgWide makes a created conversation available to the whole organization
+func OrgWide() Option {
+	return &param{key: "org_wide", val: true}
+}
+
+// Discoverability sets discoverability of a created workspace: open, closed, invite_only or unlisted
+func Discoverability(discoverability string) Option {
+	return &param{key: "team_discoverability", val: discoverability}
+}
+
+func (opt *param) apply(p params) {
+	p[opt.key] = opt.val
+}
+
+func (opts multiParam) apply(p params) {
+	for _, opt := range opts {
+		opt.apply(p)
+	}
+}
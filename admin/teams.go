@@ -0,0 +1,155 @@
+// Package admin - teams
+package admin
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kryabinin/go-slack"
+)
+
+type (
+	// Teams provides api to manage workspaces of the organization
+	Teams interface {
+		// ListTeams lists workspaces of the organization, use Cursor and Limit options to paginate
+		ListTeams(ctx context.Context, opts ...Option) (TeamsList, error)
+
+		// IterateTeams passes every workspace of the organization to the function until there are no more pages or
+		// the function returns an error
+		IterateTeams(ctx context.Context, fn func(team Team) error, opts ...Option) error
+
+		// CreateTeam creates a workspace, returns its id. Use TeamDescription and Discoverability options to set
+		// the settings on creation
+		CreateTeam(ctx context.Context, domain, name string, opts ...Option) (string, error)
+
+		// GetTeamSettings gets settings of a workspace
+		GetTeamSettings(ctx context.Context, teamID string) (TeamSettings, error)
+
+		// SetTeamName changes name of a workspace
+		SetTeamName(ctx context.Context, teamID, name string) error
+
+		// SetTeamDescription changes description of a workspace
+		SetTeamDescription(ctx context.Context, teamID, description string) error
+
+		// SetTeamDiscoverability changes discoverability of a workspace: open, closed, invite_only or unlisted
+		SetTeamDiscoverability(ctx context.Context, teamID, discoverability string) error
+
+		// SetTeamDefaultChannels sets channels new members of a workspace are added to
+		SetTeamDefaultChannels(ctx context.Context, teamID string, channelIDs []string) error
+	}
+
+	// Team workspace of the organization
+	Team struct {
+		// ID workspace identifier
+		ID string `json:"id"`
+
+		// Name workspace name
+		Name string `json:"name"`
+
+		// Discoverability who can find and join the workspace: open, closed, invite_only or unlisted
+		Discoverability string `json:"discoverability"`
+
+		// PrimaryOwner primary owner of the workspace
+		PrimaryOwner struct {
+			// UserID owner's identifier
+			UserID string `json:"user_id"`
+
+			// Email owner's email
+			Email string `json:"email"`
+		} `json:"primary_owner"`
+
+		// TeamUrl url of the workspace
+		TeamUrl string `json:"team_url"`
+	}
+
+	// TeamsList page of workspaces
+	TeamsList struct {
+		// Teams list of workspaces
+		Teams []Team `json:"teams"`
+
+		// ResponseMetadata pagination information
+		ResponseMetadata slack.ResponseMetadata `json:"response_metadata"`
+	}
+
+	// TeamSettings settings of a workspace
+	TeamSettings struct {
+		slack.Team
+
+		// Description workspace description
+		Description string `json:"description"`
+
+		// Discoverability who can find and join the workspace: open, closed, invite_only or unlisted
+		Discoverability string `json:"discoverability"`
+
+		// DefaultChannels channels new members are added to
+		DefaultChannels []string `json:"default_channels"`
+	}
+
+	teamCreateResponse struct {
+		Team string `json:"team"`
+	}
+
+	teamSettingsResponse struct {
+		Team TeamSettings `json:"team"`
+	}
+)
+
+// ListTeams implementation
+func (c *client) ListTeams(ctx context.Context, opts ...Option) (TeamsList, error) {
+	return call[TeamsList](ctx, c, "admin.teams.list", params{}, opts)
+}
+
+// IterateTeams implementation
+func (c *client) IterateTeams(ctx context.Context, fn func(team Team) error, opts ...Option) error {
+	return iterate(opts, func(opts ...Option) (TeamsList, error) {
+		return c.ListTeams(ctx, opts...)
+	}, func(page TeamsList) ([]Team, string) {
+		return page.Teams, page.ResponseMetadata.NextCursor
+	}, fn)
+}
+
+// CreateTeam implementation
+func (c *client) CreateTeam(ctx context.Context, domain, name string, opts ...Option) (string, error) {
+	resp, err := call[teamCreateResponse](ctx, c, "admin.teams.create",
+		params{"team_domain": domain, "team_name": name}, opts)
+
+	return resp.Team, err
+}
+
+// GetTeamSettings implementation
+func (c *client) GetTeamSettings(ctx context.Context, teamID string) (TeamSettings, error) {
+	resp, err := call[teamSettingsResponse](ctx, c, "admin.teams.settings.info", params{"team_id": teamID}, nil)
+
+	return resp.Team, err
+}
+
+// SetTeamName implementation
+func (c *client) SetTeamName(ctx context.Context, teamID, name string) error {
+	_, err := call[empty](ctx, c, "admin.teams.settings.setName", params{"team_id": teamID, "name": name}, nil)
+
+	return err
+}
+
+// SetTeamDescription implementation
+func (c *client) SetTeamDescription(ctx context.Context, teamID, description string) error {
+	_, err := call[empty](ctx, c, "admin.teams.settings.setDescription",
+		params{"team_id": teamID, "description": description}, nil)
+
+	return err
+}
+
+// SetTeamDiscoverability implementation
+func (c *client) SetTeamDiscoverability(ctx context.Context, teamID, discoverability string) error {
+	_, err := call[empty](ctx, c, "admin.teams.settings.setDiscoverability",
+		params{"team_id": teamID, "discoverability": discoverability}, nil)
+
+	return err
+}
+
+// SetTeamDefaultChannels implementation
+func (c *client) SetTeamDefaultChannels(ctx context.Context, teamID string, channelIDs []string) error {
+	_, err := call[empty](ctx, c, "admin.teams.settings.setDefaultChannels",
+		params{"team_id": teamID, "channel_ids": strings.Join(channelIDs, ",")}, nil)
+
+	return err
+}
@@ -0,0 +1,138 @@
+// Package admin - users
+package admin
+
+import (
+	"context"
+
+	"github.com/kryabinin/go-slack"
+)
+
+type (
+	// Users provides api to manage users of the organization
+	Users interface {
+		// ListUsers lists users of a workspace, use Cursor and Limit options to paginate
+		ListUsers(ctx context.Context, teamID string, opts ...Option) (UsersList, error)
+
+		// IterateUsers passes every user of a workspace to the function until there are no more pages or the function
+		// returns an error, use Limit option to set the page size
+		IterateUsers(ctx context.Context, teamID string, fn func(user User) error, opts ...Option) error
+
+		// InviteUser invites a user to a workspace and adds them to the channels
+		InviteUser(ctx context.Context, teamID, email string, channelIDs []string, opts ...Option) error
+
+		// AssignUser adds an existing organization user to a workspace
+		AssignUser(ctx context.Context, teamID, userID string, opts ...Option) error
+
+		// RemoveUser removes a user from a workspace
+		RemoveUser(ctx context.Context, teamID, userID string) error
+
+		// SetAdmin makes a user an admin of a workspace
+		SetAdmin(ctx context.Context, teamID, userID string) error
+
+		// ResetSessions signs a user out of all sessions, use MobileOnly or WebOnly options to limit the reset
+		ResetSessions(ctx context.Context, userID string, opts ...Option) error
+	}
+
+	// User user of a workspace
+	User struct {
+		// ID user identifier
+		ID string `json:"id"`
+
+		// Email user's email
+		Email string `json:"email"`
+
+		// Username user's name
+		Username string `json:"username"`
+
+		// FullName user's full name
+		FullName string `json:"full_name"`
+
+		// IsAdmin the user is an admin of the workspace
+		IsAdmin bool `json:"is_admin"`
+
+		// IsOwner the user is an owner of the workspace
+		IsOwner bool `json:"is_owner"`
+
+		// IsPrimaryOwner the user is the primary owner of the workspace
+		IsPrimaryOwner bool `json:"is_primary_owner"`
+
+		// IsRestricted the user is a multi-channel guest
+		IsRestricted bool `json:"is_restricted"`
+
+		// IsUltraRestricted the user is a single-channel guest
+		IsUltraRestricted bool `json:"is_ultra_restricted"`
+
+		// IsBot the user is a bot
+		IsBot bool `json:"is_bot"`
+
+		// IsActive the user is not deactivated
+		IsActive bool `json:"is_active"`
+
+		// DateCreated a unix timestamp when the user was created
+		DateCreated int64 `json:"date_created"`
+
+		// Workspaces workspaces of the organization the user is a member of
+		Workspaces []string `json:"workspaces"`
+	}
+
+	// UsersList page of users
+	UsersList struct {
+		// Users list of users
+		Users []User `json:"users"`
+
+		// ResponseMetadata pagination information
+		ResponseMetadata slack.ResponseMetadata `json:"response_metadata"`
+	}
+)
+
+// ListUsers implementation
+func (c *client) ListUsers(ctx context.Context, teamID string, opts ...Option) (UsersList, error) {
+	return call[UsersList](ctx, c, "admin.users.list", params{"team_id": teamID}, opts)
+}
+
+// IterateUsers implementation
+func (c *client) IterateUsers(ctx context.Context, teamID string, fn func(user User) error, opts ...Option) error {
+	return iterate(opts, func(opts ...Option) (UsersList, error) {
+		return c.ListUsers(ctx, teamID, opts...)
+	}, func(page UsersList) ([]User, string) {
+		return page.Users, page.ResponseMetadata.NextCursor
+	}, fn)
+}
+
+// InviteUser implementation
+func (c *client) InviteUser(ctx context.Context, teamID, email string, channelIDs []string, opts ...Option) error {
+	p := params{"team_id": teamID, "email": email}
+	ChannelIDs(channelIDs...).apply(p)
+
+	_, err := call[empty](ctx, c, "admin.users.invite", p, opts)
+
+	return err
+}
+
+// AssignUser implementation
+func (c *client) AssignUser(ctx context.Context, teamID, userID string, opts ...Option) error {
+	_, err := call[empty](ctx, c, "admin.users.assign", params{"team_id": teamID, "user_id": userID}, opts)
+
+	return err
+}
+
+// RemoveUser implementation
+func (c *client) RemoveUser(ctx context.Context, teamID, userID string) error {
+	_, err := call[empty](ctx, c, "admin.users.remove", params{"team_id": teamID, "user_id": userID}, nil)
+
+	return err
+}
+
+// SetAdmin implementation
+func (c *client) SetAdmin(ctx context.Context, teamID, userID string) error {
+	_, err := call[empty](ctx, c, "admin.users.setAdmin", params{"team_id": teamID, "user_id": userID}, nil)
+
+	return err
+}
+
+// ResetSessions implementation
+func (c *client) ResetSessions(ctx context.Context, userID string, opts ...Option) error {
+	_, err := call[empty](ctx, c, "admin.users.session.reset", params{"user_id": userID}, opts)
+
+	return err
+}
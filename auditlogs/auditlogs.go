@@ -0,0 +1,304 @@
+// Package auditlogs - client of Audit Logs API of Enterprise Grid organizations. The api requires a user token of
+// an org owner with auditlogs:read scope
+package auditlogs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/kryabinin/go-slack"
+)
+
+// DefaultBaseUrl base url of Audit Logs API
+const DefaultBaseUrl = "https://api.slack.com/audit/v1"
+
+type (
+	// Client provides api to read audit logs
+	Client interface {
+		// GetLogs gets a page of audit log entries, newest first. Use filters to narrow the entries and Cursor to
+		// get the next page
+		GetLogs(ctx context.Context, filters ...Filter) (LogsPage, error)
+
+		// IterateLogs passes every entry matching the filters to the function, newest first, until there are no more
+		// pages or the function returns an error
+		IterateLogs(ctx context.Context, fn func(entry Entry) error, filters ...Filter) error
+
+		// GetSchemas gets types of entities audit logs can contain
+		GetSchemas(ctx context.Context) ([]Schema, error)
+
+		// GetActions gets actions audit logs can contain grouped by entity type
+		GetActions(ctx context.Context) (map[string][]string, error)
+	}
+
+	// Entry audit log entry
+	Entry struct {
+		// ID entry identifier
+		ID string `json:"id"`
+
+		// DateCreate a unix timestamp when the action happened
+		DateCreate int64 `json:"date_create"`
+
+		// Action action happened, e.g. user_login or file_downloaded
+		Action string `json:"action"`
+
+		// Actor who performed the action
+		Actor Actor `json:"actor"`
+
+		// Entity the entity the action was performed on
+		Entity Entity `json:"entity"`
+
+		// Context where the action happened
+		Context Context `json:"context"`
+
+		// Details additional details of the action, its format depends on the action
+		Details json.RawMessage `json:"details,omitempty"`
+	}
+
+	// Actor actor of an entry
+	Actor struct {
+		// Type actor type, usually user
+		Type string `json:"type"`
+
+		// User the user performed the action
+		User *User `json:"user,omitempty"`
+	}
+
+	// User user of an entry
+	User struct {
+		// ID user identifier
+		ID string `json:"id"`
+
+		// Name user's name
+		Name string `json:"name"`
+
+		// Email user's email
+		Email string `json:"email"`
+
+		// Team workspace of the user
+		Team string `json:"team"`
+	}
+
+	// Entity entity of an entry, only the field of the entity type is set
+	Entity struct {
+		// Type entity type, e.g. user, channel, file, app, workspace or enterprise
+		Type string `json:"type"`
+
+		// User user entity
+		User *User `json:"user,omitempty"`
+
+		// Channel channel entity
+		Channel *Channel `json:"channel,omitempty"`
+
+		// File file entity
+		File *File `json:"file,omitempty"`
+
+		// App app entity
+		App *App `json:"app,omitempty"`
+
+		// Workspace workspace entity
+		Workspace *Location `json:"workspace,omitempty"`
+
+		// Enterprise organization entity
+		Enterprise *Location `json:"enterprise,omitempty"`
+	}
+
+	// Channel channel of an entry
+	Channel struct {
+		// ID channel identifier
+		ID string `json:"id"`
+
+		// Name channel name
+		Name string `json:"name"`
+
+		// Privacy public or private
+		Privacy string `json:"privacy"`
+
+		// IsShared the channel is shared with another organization
+		IsShared bool `json:"is_shared"`
+
+		// IsOrgShared the channel is shared to the whole organization
+		IsOrgShared bool `json:"is_org_shared"`
+	}
+
+	// File file of an entry
+	File struct {
+		// ID file identifier
+		ID string `json:"id"`
+
+		// Name file name
+		Name string `json:"name"`
+
+		// Filetype type of the file
+		Filetype string `json:"filetype"`
+
+		// Title file title
+		Title string `json:"title"`
+	}
+
+	// App app of an entry
+	App struct {
+		// ID app identifier
+		ID string `json:"id"`
+
+		// Name app name
+		Name string `json:"name"`
+
+		// IsDistributed the app is distributed to other organizations
+		IsDistributed bool `json:"is_distributed"`
+
+		// IsDirectoryApproved the app is listed in the app directory
+		IsDirectoryApproved bool `json:"is_directory_approved"`
+
+		// Scopes scopes of the app
+		Scopes []string `json:"scopes"`
+	}
+
+	// Location workspace or organization
+	Location struct {
+		// Type workspace or enterprise
+		Type string `json:"type,omitempty"`
+
+		// ID workspace or organization identifier
+		ID string `json:"id"`
+
+		// Name workspace or organization name
+		Name string `json:"name"`
+
+		// Domain workspace or organization domain
+		Domain string `json:"domain"`
+	}
+
+	// Context context of an entry
+	Context struct {
+		// Location workspace or organization where the action happened
+		Location Location `json:"location"`
+
+		// UserAgent user agent of the actor
+		UserAgent string `json:"ua"`
+
+		// IPAddress ip address of the actor
+		IPAddress string `json:"ip_address"`
+
+		// SessionID session of the actor
+		SessionID int64 `json:"session_id"`
+	}
+
+	// LogsPage page of audit log entries
+	LogsPage struct {
+		// Entries entries of the page
+		Entries []Entry `json:"entries"`
+
+		// ResponseMetadata pagination information
+		ResponseMetadata slack.ResponseMetadata `json:"response_metadata"`
+	}
+
+	// Schema schema of an entity type
+	Schema struct {
+		// Type entity type
+		Type string `json:"type"`
+
+		// Entity description of entity fields
+		Entity json.RawMessage `json:"entity"`
+	}
+
+	client struct {
+		api slack.Client
+	}
+
+	apiResponse struct {
+		// Ok false on errors, not set on success
+		Ok *bool `json:"ok"`
+
+		// Error short machine-readable error code
+		Error string `json:"error"`
+
+		LogsPage
+
+		// Schemas schemas of entity types
+		Schemas []Schema `json:"schemas"`
+
+		// Actions actions grouped by entity type
+		Actions map[string][]string `json:"actions"`
+	}
+)
+
+// NewClient is audit logs client constructor
+func NewClient(token string, opts ...Option) Client {
+	clientOpts := []slack.ClientOption{slack.WithBaseUrl(DefaultBaseUrl)}
+	for _, opt := range opts {
+		clientOpts = opt.apply(clientOpts)
+	}
+
+	return &client{api: slack.NewClient(token, clientOpts...)}
+}
+
+// GetLogs implementation
+func (c *client) GetLogs(ctx context.Context, filters ...Filter) (LogsPage, error) {
+	resp, err := c.get(ctx, "logs?"+filterParams(filters).Encode())
+	if err != nil {
+		return LogsPage{}, err
+	}
+
+	return resp.LogsPage, nil
+}
+
+// IterateLogs implementation
+func (c *client) IterateLogs(ctx context.Context, fn func(entry Entry) error, filters ...Filter) error {
+	cursor := ""
+	for {
+		page, err := c.GetLogs(ctx, append(filters, Cursor(cursor))...)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range page.Entries {
+			if err = fn(entry); err != nil {
+				return err
+			}
+		}
+
+		if cursor = page.ResponseMetadata.NextCursor; len(cursor) == 0 {
+			return nil
+		}
+	}
+}
+
+// GetSchemas implementation
+func (c *client) GetSchemas(ctx context.Context) ([]Schema, error) {
+	resp, err := c.get(ctx, "schemas")
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Schemas, nil
+}
+
+// GetActions implementation
+func (c *client) GetActions(ctx context.Context) (map[string][]string, error) {
+	resp, err := c.get(ctx, "actions")
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Actions, nil
+}
+
+func (c *client) get(ctx context.Context, path string) (apiResponse, error) {
+	respBody, err := c.api.SendRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return apiResponse{}, err
+	}
+
+	var resp apiResponse
+	if err = json.Unmarshal(respBody, &resp); err != nil {
+		return apiResponse{}, fmt.Errorf("can't unmarshal response: %w", err)
+	}
+
+	if resp.Ok != nil && !*resp.Ok {
+		return apiResponse{}, fmt.Errorf("slack respond with error: %s", resp.Error)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,358 @@
+package auditlogs_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kryabinin/go-slack"
+	"github.com/kryabinin/go-slack/auditlogs"
+)
+
+type fakeApi struct {
+	mu      sync.Mutex
+	entries []auditlogs.Entry
+	queries []string
+}
+
+func (f *fakeApi) add(entries ...auditlogs.Entry) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// the api returns newest entries first
+	f.entries = append(append([]auditlogs.Entry(nil), entries...), f.entries...)
+}
+
+func (f *fakeApi) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.queries = append(f.queries, r.URL.RawQuery)
+
+	var resp interface{}
+	switch r.URL.Path {
+	case "/logs":
+		oldest, _ := strconv.ParseInt(r.URL.Query().Get("oldest"), 10, 64)
+		latest, _ := strconv.ParseInt(r.URL.Query().Get("latest"), 10, 64)
+
+		var entries []auditlogs.Entry
+		for _, entry := range f.entries {
+			if entry.DateCreate >= oldest && (latest == 0 || entry.DateCreate <= latest) {
+				entries = append(entries, entry)
+			}
+		}
+
+		// pages of 2 entries, cursor is offset of the page
+		offset, _ := strconv.Atoi(r.URL.Query().Get("cursor"))
+		page := auditlogs.LogsPage{}
+		if offset < len(entries) {
+			end := offset + 2
+			if end < len(entries) {
+				page.ResponseMetadata.NextCursor = strconv.Itoa(end)
+			} else {
+				end = len(entries)
+			}
+
+			page.Entries = entries[offset:end]
+		}
+
+		resp = page
+	case "/schemas":
+		resp = map[string]interface{}{"schemas": []map[string]interface{}{{"type": "user", "entity": map[string]string{}}}}
+	case "/actions":
+		resp = map[string]interface{}{"actions": map[string][]string{"user": {"user_login", "user_logout"}}}
+	default:
+		resp = map[string]interface{}{"ok": false, "error": "invalid_action"}
+	}
+
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func newClient(srv *httptest.Server) auditlogs.Client {
+	return auditlogs.NewClient("xoxp-test", auditlogs.WithClientOptions(slack.WithBaseUrl(srv.URL)))
+}
+
+func TestClient_GetLogs(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("positive case", func(t *testing.T) {
+		api := &fakeApi{}
+		api.add(auditlogs.Entry{ID: "1", DateCreate: 100, Action: "user_login",
+			Actor: auditlogs.Actor{Type: "user", User: &auditlogs.User{ID: "U1"}}})
+
+		srv := httptest.NewServer(api)
+		defer srv.Close()
+
+		page, err := newClient(srv).GetLogs(ctx, auditlogs.ByAction("user_login", "user_logout"),
+			auditlogs.ByActor("U1"), auditlogs.Oldest(time.Unix(50, 0)), auditlogs.Limit(10))
+		assert.NoError(t, err)
+		assert.Len(t, page.Entries, 1)
+		assert.Equal(t, "U1", page.Entries[0].Actor.User.ID)
+		assert.Equal(t, "action=user_login%2Cuser_logout&actor=U1&limit=10&oldest=50", api.queries[0])
+	})
+
+	t.Run("slack respond with error", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"ok":false,"error":"feature_not_enabled"}`))
+		}))
+		defer srv.Close()
+
+		_, err := newClient(srv).GetLogs(ctx)
+		assert.Error(t, err)
+		assert.Equal(t, "slack respond with error: feature_not_enabled", err.Error())
+	})
+}
+
+func TestClient_IterateLogs(t *testing.T) {
+	api := &fakeApi{}
+	api.add(auditlogs.Entry{ID: "1", DateCreate: 1}, auditlogs.Entry{ID: "2", DateCreate: 2},
+		auditlogs.Entry{ID: "3", DateCreate: 3})
+
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+
+	var ids []string
+	err := newClient(srv).IterateLogs(context.Background(), func(entry auditlogs.Entry) error {
+		ids = append(ids, entry.ID)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "3"}, ids)
+	assert.Equal(t, []string{"", "cursor=2"}, api.queries)
+}
+
+func TestClient_SchemasAndActions(t *testing.T) {
+	srv := httptest.NewServer(&fakeApi{})
+	defer srv.Close()
+
+	c := newClient(srv)
+
+	schemas, err := c.GetSchemas(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "user", schemas[0].Type)
+
+	actions, err := c.GetActions(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]string{"user": {"user_login", "user_logout"}}, actions)
+}
+
+func TestStreamer(t *testing.T) {
+	t.Run("resumes from checkpoint", func(t *testing.T) {
+		api := &fakeApi{}
+		api.add(auditlogs.Entry{ID: "1", DateCreate: 10}, auditlogs.Entry{ID: "2", DateCreate: 20},
+			auditlogs.Entry{ID: "3", DateCreate: 20}, auditlogs.Entry{ID: "4", DateCreate: 30})
+
+		srv := httptest.NewServer(api)
+		defer srv.Close()
+
+		checkpoint := &auditlogs.MemoryCheckpoint{}
+		assert.NoError(t, checkpoint.Save(context.Background(), auditlogs.Position{DateCreate: 20, IDs: []string{"2"}}))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var (
+			mu  sync.Mutex
+			ids []string
+		)
+
+		streamer := auditlogs.NewStreamer(newClient(srv), checkpoint, auditlogs.WithInterval(10*time.Millisecond))
+
+		done := make(chan error)
+		go func() {
+			done <- streamer.Run(ctx, func(_ context.Context, entry auditlogs.Entry) error {
+				mu.Lock()
+				defer mu.Unlock()
+
+				ids = append(ids, entry.ID)
+				if entry.ID == "5" {
+					cancel()
+				}
+
+				return nil
+			})
+		}()
+
+		time.Sleep(30 * time.Millisecond)
+		api.add(auditlogs.Entry{ID: "5", DateCreate: time.Now().Unix()})
+
+		select {
+		case err := <-done:
+			assert.True(t, errors.Is(err, context.Canceled))
+		case <-time.After(time.Second):
+			t.Fatal("streamer is not stopped")
+		}
+
+		assert.Equal(t, []string{"3", "4", "5"}, ids)
+
+		pos, err := checkpoint.Load(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"5"}, pos.IDs)
+	})
+
+	t.Run("handler error", func(t *testing.T) {
+		api := &fakeApi{}
+		api.add(auditlogs.Entry{ID: "1", DateCreate: 10}, auditlogs.Entry{ID: "2", DateCreate: 20},
+			auditlogs.Entry{ID: "3", DateCreate: 30})
+
+		srv := httptest.NewServer(api)
+		defer srv.Close()
+
+		dir, err := ioutil.TempDir("", "auditlogs")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		checkpoint := auditlogs.NewFileCheckpoint(filepath.Join(dir, "checkpoint.json"))
+		expErr := errors.New("test error")
+
+		var (
+			ids    []string
+			failed bool
+		)
+
+		handler := func(_ context.Context, entry auditlogs.Entry) error {
+			if entry.ID == "2" && !failed {
+				failed = true
+				return expErr
+			}
+
+			ids = append(ids, entry.ID)
+			return nil
+		}
+
+		streamer := auditlogs.NewStreamer(newClient(srv), checkpoint, auditlogs.WithStart(time.Unix(10, 0)))
+		assert.Equal(t, expErr, streamer.Run(context.Background(), handler))
+
+		pos, err := checkpoint.Load(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, auditlogs.Position{DateCreate: 10, IDs: []string{"1"}}, pos)
+
+		// the failed entry is handled again after restart
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		streamer = auditlogs.NewStreamer(newClient(srv), checkpoint, auditlogs.WithInterval(10*time.Millisecond))
+		assert.True(t, errors.Is(streamer.Run(ctx, handler), context.DeadlineExceeded))
+		assert.Equal(t, []string{"1", "2", "3"}, ids)
+	})
+
+	t.Run("progress is saved by windows", func(t *testing.T) {
+		api := &fakeApi{}
+		api.add(auditlogs.Entry{ID: "1", DateCreate: 10}, auditlogs.Entry{ID: "2", DateCreate: 20},
+			auditlogs.Entry{ID: "3", DateCreate: 30}, auditlogs.Entry{ID: "4", DateCreate: 40},
+			auditlogs.Entry{ID: "5", DateCreate: 50})
+
+		// windows starting after the third entry fail
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if oldest, _ := strconv.ParseInt(r.URL.Query().Get("oldest"), 10, 64); oldest > 30 {
+				_, _ = w.Write([]byte(`{"ok":false,"error":"internal_error"}`))
+				return
+			}
+
+			api.ServeHTTP(w, r)
+		}))
+		defer srv.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		var ids []string
+		checkpoint := &auditlogs.MemoryCheckpoint{}
+		streamer := auditlogs.NewStreamer(newClient(srv), checkpoint,
+			auditlogs.WithStart(time.Unix(10, 0)),
+			auditlogs.WithInterval(10*time.Millisecond),
+		)
+
+		assert.Error(t, streamer.Run(ctx, func(_ context.Context, entry auditlogs.Entry) error {
+			ids = append(ids, entry.ID)
+			return nil
+		}))
+		assert.Equal(t, []string{"1", "2", "3"}, ids[:3])
+		assert.NotContains(t, ids, "5")
+
+		pos, err := checkpoint.Load(context.Background())
+		assert.NoError(t, err)
+		assert.True(t, pos.DateCreate >= 30)
+
+		for _, query := range api.queries {
+			assert.Contains(t, query, "latest=")
+			assert.NotContains(t, query, "cursor=")
+		}
+	})
+
+	t.Run("dense second overflowing a page", func(t *testing.T) {
+		api := &fakeApi{}
+		api.add(auditlogs.Entry{ID: "1", DateCreate: 10}, auditlogs.Entry{ID: "2", DateCreate: 20},
+			auditlogs.Entry{ID: "3", DateCreate: 20}, auditlogs.Entry{ID: "4", DateCreate: 20},
+			auditlogs.Entry{ID: "5", DateCreate: 21}, auditlogs.Entry{ID: "6", DateCreate: 30})
+
+		srv := httptest.NewServer(api)
+		defer srv.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		var ids []string
+		streamer := auditlogs.NewStreamer(newClient(srv), &auditlogs.MemoryCheckpoint{},
+			auditlogs.WithStart(time.Unix(10, 0)),
+			auditlogs.WithInterval(time.Second),
+		)
+
+		assert.True(t, errors.Is(streamer.Run(ctx, func(_ context.Context, entry auditlogs.Entry) error {
+			ids = append(ids, entry.ID)
+			return nil
+		}), context.DeadlineExceeded))
+		if assert.Len(t, ids, 6) {
+			assert.Equal(t, "1", ids[0])
+			assert.ElementsMatch(t, []string{"2", "3", "4"}, ids[1:4])
+			assert.Equal(t, []string{"5", "6"}, ids[4:])
+		}
+
+		// a single poll reaches the current time instead of requesting the dense second again
+		api.mu.Lock()
+		defer api.mu.Unlock()
+		assert.True(t, len(api.queries) < 200)
+	})
+
+	t.Run("errors of getting logs", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"ok":false,"error":"not_allowed"}`))
+		}))
+		defer srv.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		var (
+			mu   sync.Mutex
+			errs []error
+		)
+
+		streamer := auditlogs.NewStreamer(newClient(srv), &auditlogs.MemoryCheckpoint{},
+			auditlogs.WithInterval(10*time.Millisecond),
+			auditlogs.WithErrorHandler(func(_ context.Context, err error) {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}))
+
+		assert.Error(t, streamer.Run(ctx, func(context.Context, auditlogs.Entry) error { return nil }))
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		assert.NotEmpty(t, errs)
+		assert.Equal(t, "can't get logs: slack respond with error: not_allowed", errs[0].Error())
+	})
+}
@@ -0,0 +1,139 @@
+// Package auditlogs - checkpoints
+package auditlogs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+type (
+	// Position position of a stream: date_create of the last handled entry and ids of handled entries created at
+	// the same second, they are skipped after resuming
+	Position struct {
+		// DateCreate date_create of the last handled entry
+		DateCreate int64 `json:"date_create"`
+
+		// IDs ids of handled entries with the date_create
+		IDs []string `json:"ids"`
+	}
+
+	// Checkpoint stores position of a stream to resume from after restarts
+	Checkpoint interface {
+		// Load returns the saved position, zero position if nothing is saved yet
+		Load(ctx context.Context) (Position, error)
+
+		// Save saves the position
+		Save(ctx context.Context, pos Position) error
+	}
+
+	// FileCheckpoint stores position in a json file
+	FileCheckpoint struct {
+		path string
+	}
+
+	// MemoryCheckpoint stores position in memory, positions are lost after restarts
+	MemoryCheckpoint struct {
+		mu  sync.Mutex
+		pos Position
+	}
+)
+
+// NewFileCheckpoint is file checkpoint constructor
+func NewFileCheckpoint(path string) *FileCheckpoint {
+	return &FileCheckpoint{path: path}
+}
+
+// Load implementation
+func (c *FileCheckpoint) Load(_ context.Context) (Position, error) {
+	data, err := ioutil.ReadFile(c.path)
+	if os.IsNotExist(err) {
+		return Position{}, nil
+	}
+
+	if err != nil {
+		return Position{}, fmt.Errorf("can't read checkpoint: %w", err)
+	}
+
+	var pos Position
+	if err = json.Unmarshal(data, &pos); err != nil {
+		return Position{}, fmt.Errorf("can't unmarshal checkpoint: %w", err)
+	}
+
+	return pos, nil
+}
+
+// Save implementation. The file is replaced atomically
+func (c *FileCheckpoint) Save(_ context.Context, pos Position) error {
+	data, err := json.Marshal(pos)
+	if err != nil {
+		return fmt.Errorf("can't marshal checkpoint: %w", err)
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(c.path), filepath.Base(c.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("can't create temp file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("can't write checkpoint: %w", err)
+	}
+
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("can't write checkpoint: %w", err)
+	}
+
+	if err = os.Rename(tmp.Name(), c.path); err != nil {
+		return fmt.Errorf("can't replace checkpoint: %w", err)
+	}
+
+	return nil
+}
+
+// Load implementation
+func (c *MemoryCheckpoint) Load(_ context.Context) (Position, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.pos, nil
+}
+
+// Save implementation
+func (c *MemoryCheckpoint) Save(_ context.Context, pos Position) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.pos = pos
+
+	return nil
+}
+
+// handled reports whether the entry is handled before the position
+func (p Position) handled(entry Entry) bool {
+	if entry.DateCreate != p.DateCreate {
+		return entry.DateCreate < p.DateCreate
+	}
+
+	for _, id := range p.IDs {
+		if id == entry.ID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// advance moves the position to the entry
+func (p Position) advance(entry Entry) Position {
+	if entry.DateCreate != p.DateCreate {
+		return Position{DateCreate: entry.DateCreate, IDs: []string{entry.ID}}
+	}
+
+	return Position{DateCreate: p.DateCreate, IDs: append(append([]string(nil), p.IDs...), entry.ID)}
+}
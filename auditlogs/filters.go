@@ -0,0 +1,74 @@
+// Package auditlogs - filters
+package auditlogs
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type (
+	// Filter to apply optional parameters to logs requests
+	Filter interface {
+		apply(params url.Values)
+	}
+
+	filterParam struct {
+		key string
+		val string
+	}
+)
+
+// ByAction filters entries by actions, e.g. user_login or file_downloaded
+func ByAction(actions ...string) Filter {
+	return &filterParam{key: "action", val: strings.Join(actions, ",")}
+}
+
+// ByActor filters entries by the user performed the action
+func ByActor(userID string) Filter {
+	return &filterParam{key: "actor", val: userID}
+}
+
+// ByEntity filters entries by the entity the action was performed on, e.g. a user, channel or file id
+func ByEntity(entityID string) Filter {
+	return &filterParam{key: "entity", val: entityID}
+}
+
+// Oldest filters entries happened at or after the time
+func Oldest(oldest time.Time) Filter {
+	return &filterParam{key: "oldest", val: strconv.FormatInt(oldest.Unix(), 10)}
+}
+
+// Latest filters entries happened at or before the time
+func Latest(latest time.Time) Filter {
+	return &filterParam{key: "latest", val: strconv.FormatInt(latest.Unix(), 10)}
+}
+
+// Limit sets the maximum number of entries of a page
+func Limit(limit int) Filter {
+	return &filterParam{key: "limit", val: strconv.Itoa(limit)}
+}
+
+// Cursor sets a cursor returned in the previous page's response metadata
+func Cursor(cursor string) Filter {
+	return &filterParam{key: "cursor", val: cursor}
+}
+
+func (opt *filterParam) apply(params url.Values) {
+	if len(opt.val) == 0 {
+		params.Del(opt.key)
+		return
+	}
+
+	params.Set(opt.key, opt.val)
+}
+
+func filterParams(filters []Filter) url.Values {
+	params := url.Values{}
+	for _, filter := range filters {
+		filter.apply(params)
+	}
+
+	return params
+}
@@ -0,0 +1,87 @@
+// Package auditlogs - options
+package auditlogs
+
+import (
+	"context"
+	"time"
+
+	"github.com/kryabinin/go-slack"
+)
+
+type (
+	// Option to use optional parameters in audit logs client
+	Option interface {
+		apply(clientOpts []slack.ClientOption) []slack.ClientOption
+	}
+
+	// StreamOption to use optional parameters in streamer
+	StreamOption interface {
+		apply(s *Streamer)
+	}
+
+	withClientOptions struct {
+		opts []slack.ClientOption
+	}
+
+	withInterval struct {
+		interval time.Duration
+	}
+
+	withStart struct {
+		start time.Time
+	}
+
+	withFilters struct {
+		filters []Filter
+	}
+
+	withErrorHandler struct {
+		handler func(ctx context.Context, err error)
+	}
+)
+
+// WithClientOptions sets options of the api client, e.g. http client, rate limiter or middlewares. Base url is
+// Audit Logs API one unless it is replaced by the options
+func WithClientOptions(opts ...slack.ClientOption) Option {
+	return &withClientOptions{opts: opts}
+}
+
+func (opt *withClientOptions) apply(clientOpts []slack.ClientOption) []slack.ClientOption {
+	return append(clientOpts, opt.opts...)
+}
+
+// WithInterval sets interval between polls of the streamer, 1 minute by default
+func WithInterval(interval time.Duration) StreamOption {
+	return &withInterval{interval: interval}
+}
+
+func (opt *withInterval) apply(s *Streamer) {
+	s.interval = opt.interval
+}
+
+// WithStart sets time the stream starts from when the checkpoint has no saved position, current time by default
+func WithStart(start time.Time) StreamOption {
+	return &withStart{start: start}
+}
+
+func (opt *withStart) apply(s *Streamer) {
+	s.start = opt.start
+}
+
+// WithFilters filters streamed entries, e.g. by actions or actor
+func WithFilters(filters ...Filter) StreamOption {
+	return &withFilters{filters: filters}
+}
+
+func (opt *withFilters) apply(s *Streamer) {
+	s.filters = append(s.filters, opt.filters...)
+}
+
+// WithErrorHandler sets handler of errors of getting logs
+func WithErrorHandler(handler func(ctx context.Context, err error)) StreamOption {
+	return &withErrorHandler{handler: handler}
+}
+
+func (opt *withErrorHandler) apply(s *Streamer) {
+	s.onError = opt.handler
+}
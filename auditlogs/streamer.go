@@ -0,0 +1,180 @@
+// Package auditlogs - streamer
+package auditlogs
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	defaultStreamInterval = time.Minute
+	streamPageLimit       = 200
+)
+
+type (
+	// HandlerFunc handles an audit log entry
+	HandlerFunc func(ctx context.Context, entry Entry) error
+
+	// Streamer polls audit logs and passes new entries to a handler oldest first. Position of the last handled
+	// entry is saved to the checkpoint, so the stream resumes from it after restarts
+	Streamer struct {
+		api        Client
+		checkpoint Checkpoint
+		interval   time.Duration
+		start      time.Time
+		filters    []Filter
+		onError    func(ctx context.Context, err error)
+	}
+)
+
+// NewStreamer is streamer constructor. Without a saved position the stream starts from the current time
+func NewStreamer(api Client, checkpoint Checkpoint, opts ...StreamOption) *Streamer {
+	s := &Streamer{
+		api:        api,
+		checkpoint: checkpoint,
+		interval:   defaultStreamInterval,
+		onError:    func(context.Context, error) {},
+	}
+
+	for _, opt := range opts {
+		opt.apply(s)
+	}
+
+	return s
+}
+
+// Run streams entries to the handler until the context is done, the handler returns an error or the position can't
+// be saved. Errors of getting logs are passed to the error handler and the poll is retried after the interval
+func (s *Streamer) Run(ctx context.Context, handler HandlerFunc) error {
+	pos, err := s.checkpoint.Load(ctx)
+	if err != nil {
+		return err
+	}
+
+	if pos.DateCreate == 0 {
+		start := s.start
+		if start.IsZero() {
+			start = time.Now()
+		}
+
+		pos = Position{DateCreate: start.Unix()}
+	}
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if pos, err = s.poll(ctx, pos, handler); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll handles entries created since the position and returns the new position. The api returns newest entries
+// first, so logs are requested in time windows fitting a single page. The window is halved while it has more entries,
+// entries of a window are handled oldest first and the position is saved after every window
+func (s *Streamer) poll(ctx context.Context, pos Position, handler HandlerFunc) (Position, error) {
+	now := time.Now().Unix()
+	span := now - pos.DateCreate
+
+	for {
+		latest := pos.DateCreate + span
+		if latest > now {
+			latest = now
+		}
+
+		entries, full, err := s.window(ctx, pos.DateCreate, latest)
+		if err != nil {
+			if ctx.Err() == nil {
+				s.onError(ctx, fmt.Errorf("can't get logs: %w", err))
+			}
+
+			return pos, nil
+		}
+
+		if full {
+			span = (latest - pos.DateCreate) / 2
+			continue
+		}
+
+		prev := pos
+		for _, entry := range entries {
+			if pos.handled(entry) {
+				continue
+			}
+
+			if err = handler(ctx, entry); err != nil {
+				break
+			}
+
+			pos = pos.advance(entry)
+		}
+
+		// all entries of a past window are handled, the next window starts after it. The current window is not
+		// skipped, entries can appear in it later
+		if err == nil && latest < now {
+			pos = Position{DateCreate: latest + 1}
+		}
+
+		if pos.DateCreate != prev.DateCreate || len(pos.IDs) != len(prev.IDs) {
+			if saveErr := s.checkpoint.Save(ctx, pos); saveErr != nil {
+				return pos, fmt.Errorf("can't save checkpoint: %w", saveErr)
+			}
+		}
+
+		if err != nil || latest >= now {
+			return pos, err
+		}
+
+		// a single second window is narrowed to zero span, the next windows grow again
+		if span *= 2; span < 1 {
+			span = 1
+		}
+	}
+}
+
+// window returns entries created between oldest and latest inclusive sorted oldest first. Reports true instead if
+// the window has more entries than a page and can be narrowed, entries of a single second are loaded at once
+func (s *Streamer) window(ctx context.Context, oldest, latest int64) ([]Entry, bool, error) {
+	filters := append(append([]Filter(nil), s.filters...),
+		Oldest(time.Unix(oldest, 0)), Latest(time.Unix(latest, 0)), Limit(streamPageLimit))
+
+	page, err := s.api.GetLogs(ctx, filters...)
+	if err != nil {
+		return nil, false, err
+	}
+
+	entries := page.Entries
+	if len(page.ResponseMetadata.NextCursor) > 0 {
+		if latest > oldest {
+			return nil, true, nil
+		}
+
+		entries = nil
+		if err = s.api.IterateLogs(ctx, func(entry Entry) error {
+			entries = append(entries, entry)
+			return nil
+		}, filters...); err != nil {
+			return nil, false, err
+		}
+	}
+
+	sorted := make([]Entry, 0, len(entries))
+	for i := len(entries) - 1; i >= 0; i-- {
+		sorted = append(sorted, entries[i])
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].DateCreate < sorted[j].DateCreate
+	})
+
+	return sorted, false, nil
+}
@@ -0,0 +1,224 @@
+// Package slack - auth
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// TokenTypeUnknown token of unrecognized format
+	TokenTypeUnknown TokenType = "unknown"
+
+	// TokenTypeBot bot token, starts with xoxb-
+	TokenTypeBot TokenType = "bot"
+
+	// TokenTypeUser user token, starts with xoxp-
+	TokenTypeUser TokenType = "user"
+
+	// TokenTypeApp app-level token, starts with xapp-
+	TokenTypeApp TokenType = "app"
+
+	// TokenTypeConfig app configuration token, starts with xoxe-
+	TokenTypeConfig TokenType = "config"
+
+	scopesHeader = "X-OAuth-Scopes"
+)
+
+// permanentAuthErrors auth.test errors which don't go away on retries
+var permanentAuthErrors = map[string]bool{
+	"invalid_auth":     true,
+	"not_authed":       true,
+	"account_inactive": true,
+	"token_revoked":    true,
+	"token_expired":    true,
+}
+
+type (
+	// TokenType type of slack token
+	TokenType string
+
+	// AuthInfo information about the token owner
+	AuthInfo struct {
+		// Url url of the workspace
+		Url string `json:"url"`
+
+		// Team workspace name
+		Team string `json:"team"`
+
+		// User name of the authed user
+		User string `json:"user"`
+
+		// TeamID workspace identifier
+		TeamID string `json:"team_id"`
+
+		// UserID identifier of the authed user
+		UserID string `json:"user_id"`
+
+		// BotID identifier of the bot, set only for bot tokens
+		BotID string `json:"bot_id"`
+
+		// EnterpriseID Enterprise Grid organization identifier
+		EnterpriseID string `json:"enterprise_id"`
+
+		// IsEnterpriseInstall true if the app is installed to the whole Enterprise Grid organization
+		IsEnterpriseInstall bool `json:"is_enterprise_install"`
+	}
+
+	// TokenInfo information about the token
+	TokenInfo struct {
+		AuthInfo
+
+		// Type type of the token detected by its prefix
+		Type TokenType
+
+		// Scopes list of scopes granted to the token
+		Scopes []string
+	}
+
+	authApiResponse struct {
+		// Ok indicates success or failure
+		Ok bool `json:"ok"`
+
+		// Error short machine-readable error code
+		Error string `json:"error"`
+
+		AuthInfo
+	}
+
+	// tokenInfoCache result of the token introspection shared by clients of the same token. The error is valid while
+	// failed is set, it's reset without the lock by successful calls, including the one of the introspection
+	tokenInfoCache struct {
+		mu     sync.Mutex
+		info   *TokenInfo
+		err    error
+		failed int32
+	}
+)
+
+// DetectTokenType detects type of the token by its prefix. Rotating tokens (xoxe.xoxb-, xoxe.xoxp-) are detected as
+// bot and user tokens
+func DetectTokenType(token string) TokenType {
+	token = strings.TrimPrefix(token, "xoxe.")
+
+	switch {
+	case strings.HasPrefix(token, "xoxb-"):
+		return TokenTypeBot
+	case strings.HasPrefix(token, "xoxp-"):
+		return TokenTypeUser
+	case strings.HasPrefix(token, "xapp-"):
+		return TokenTypeApp
+	case strings.HasPrefix(token, "xoxe-"):
+		return TokenTypeConfig
+	default:
+		return TokenTypeUnknown
+	}
+}
+
+// HasScope checks if the scope is granted to the token
+func (i TokenInfo) HasScope(scope string) bool {
+	for _, granted := range i.Scopes {
+		if granted == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+func authTest(ctx context.Context, c *client) (AuthInfo, error) {
+	info, err := introspectToken(ctx, c)
+	if err != nil {
+		return AuthInfo{}, err
+	}
+
+	return info.AuthInfo, nil
+}
+
+func getTokenInfo(ctx context.Context, c *client) (TokenInfo, error) {
+	c.tokenInfo.mu.Lock()
+	defer c.tokenInfo.mu.Unlock()
+
+	if atomic.LoadInt32(&c.tokenInfo.failed) == 1 {
+		return TokenInfo{}, c.tokenInfo.err
+	}
+
+	if c.tokenInfo.info != nil {
+		return *c.tokenInfo.info, nil
+	}
+
+	return cacheTokenInfo(ctx, c)
+}
+
+func verifyToken(ctx context.Context, c *client) {
+	c.tokenInfo.mu.Lock()
+	defer c.tokenInfo.mu.Unlock()
+
+	_, _ = cacheTokenInfo(ctx, c)
+}
+
+// cacheTokenInfo introspects the token and caches the result. Only successful results and permanent auth errors are
+// cached, other errors, e.g. timeouts or server errors, are retried on the next call
+func cacheTokenInfo(ctx context.Context, c *client) (TokenInfo, error) {
+	info, err := introspectToken(ctx, c)
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && permanentAuthErrors[apiErr.Code] {
+		c.tokenInfo.err = fmt.Errorf("token verification failed: %w", err)
+		atomic.StoreInt32(&c.tokenInfo.failed, 1)
+
+		return TokenInfo{}, c.tokenInfo.err
+	}
+
+	if err != nil {
+		return TokenInfo{}, err
+	}
+
+	c.tokenInfo.info = &info
+
+	return info, nil
+}
+
+func (c *tokenInfoCache) clearError() {
+	atomic.CompareAndSwapInt32(&c.failed, 1, 0)
+}
+
+func introspectToken(ctx context.Context, c *client) (TokenInfo, error) {
+	respBody, header, err := c.sendRequest(ctx, http.MethodPost, "auth.test", contentTypeJSON, nil)
+	if err != nil {
+		return TokenInfo{}, err
+	}
+
+	token, err := c.tokenSource.Token(ctx)
+	if err != nil {
+		return TokenInfo{}, fmt.Errorf("can't get token: %w", err)
+	}
+
+	var resp authApiResponse
+	if err = json.Unmarshal(respBody, &resp); err != nil {
+		return TokenInfo{}, fmt.Errorf("can't unmarshal response: %w", err)
+	}
+
+	if !resp.Ok {
+		return TokenInfo{}, &APIError{Code: resp.Error}
+	}
+
+	info := TokenInfo{
+		AuthInfo: resp.AuthInfo,
+		Type:     DetectTokenType(token),
+	}
+
+	for _, scope := range strings.Split(header.Get(scopesHeader), ",") {
+		if scope = strings.TrimSpace(scope); len(scope) > 0 {
+			info.Scopes = append(info.Scopes, scope)
+		}
+	}
+
+	return info, nil
+}
@@ -0,0 +1,224 @@
+package slack_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-slack"
+)
+
+func TestDetectTokenType(t *testing.T) {
+	testCases := []struct {
+		token   string
+		expType slack.TokenType
+	}{
+		{token: "xoxb-1-2-3", expType: slack.TokenTypeBot},
+		{token: "xoxp-1-2-3", expType: slack.TokenTypeUser},
+		{token: "xapp-1-2-3", expType: slack.TokenTypeApp},
+		{token: "xoxe-1-2-3", expType: slack.TokenTypeConfig},
+		{token: "xoxe.xoxb-1-2-3", expType: slack.TokenTypeBot},
+		{token: "xoxe.xoxp-1-2-3", expType: slack.TokenTypeUser},
+		{token: "test_token", expType: slack.TokenTypeUnknown},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.token, func(t *testing.T) {
+			assert.Equal(t, testCase.expType, slack.DetectTokenType(testCase.token))
+		})
+	}
+}
+
+func TestClient_AuthTest(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		baseUrl := "http://test.slack.com/api"
+
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, baseUrl+"/"+"auth.test", req.URL.String())
+		}).Return(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":true,"url":"https://team.slack.com/",` +
+				`"team":"Team","user":"bot","team_id":"T1","user_id":"U1","bot_id":"B1","enterprise_id":"E1"}`))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		c := slack.NewClient("xoxb-test", slack.WithBaseUrl(baseUrl), slack.WithHttpClient(httpClient))
+
+		info, err := c.AuthTest(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, slack.AuthInfo{
+			Url:          "https://team.slack.com/",
+			Team:         "Team",
+			User:         "bot",
+			TeamID:       "T1",
+			UserID:       "U1",
+			BotID:        "B1",
+			EnterpriseID: "E1",
+		}, info)
+	})
+
+	t.Run("slack respond with error", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":false,"error":"invalid_auth"}`))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		c := slack.NewClient("xoxb-test", slack.WithHttpClient(httpClient))
+
+		info, err := c.AuthTest(context.Background())
+		assert.Error(t, err)
+		assert.Equal(t, slack.AuthInfo{}, info)
+	})
+}
+
+func TestClient_TokenInfo(t *testing.T) {
+	okResponse := func() *http.Response {
+		return &http.Response{
+			Header:     http.Header{"X-Oauth-Scopes": {"chat:write, reactions:write,files:read"}},
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":true,"team_id":"T1","bot_id":"B1"}`))),
+			StatusCode: http.StatusOK,
+		}
+	}
+
+	t.Run("eager verification", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(okResponse(), nil).Once()
+
+		c := slack.NewClient(
+			"xoxb-test",
+			slack.WithTokenVerification(context.Background()),
+			slack.WithHttpClient(httpClient),
+		)
+		httpClient.AssertNumberOfCalls(t, "Do", 1)
+
+		info, err := c.TokenInfo(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, slack.TokenTypeBot, info.Type)
+		assert.Equal(t, "T1", info.TeamID)
+		assert.Equal(t, []string{"chat:write", "reactions:write", "files:read"}, info.Scopes)
+		assert.True(t, info.HasScope("reactions:write"))
+		assert.False(t, info.HasScope("admin"))
+		httpClient.AssertNumberOfCalls(t, "Do", 1)
+	})
+
+	t.Run("eager verification failed with temporary error", func(t *testing.T) {
+		expErr := errors.New("test error")
+
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(nil, expErr).Once()
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(okResponse(), nil).Once()
+
+		c := slack.NewClient(
+			"xoxb-test",
+			slack.WithTokenVerification(context.Background()),
+			slack.WithHttpClient(httpClient),
+		)
+		httpClient.AssertNumberOfCalls(t, "Do", 1)
+
+		info, err := c.TokenInfo(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "T1", info.TeamID)
+		httpClient.AssertNumberOfCalls(t, "Do", 2)
+	})
+
+	t.Run("eager verification failed with auth error", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":false,"error":"invalid_auth"}`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		c := slack.NewClient(
+			"xoxb-test",
+			slack.WithTokenVerification(context.Background()),
+			slack.WithHttpClient(httpClient),
+		)
+
+		for i := 0; i < 2; i++ {
+			info, err := c.TokenInfo(context.Background())
+			assert.Equal(t, "token verification failed: slack respond with error: invalid_auth", err.Error())
+			assert.Equal(t, slack.TokenInfo{}, info)
+		}
+		httpClient.AssertNumberOfCalls(t, "Do", 1)
+	})
+
+	t.Run("verified client", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(okResponse(), nil).Once()
+
+		c, err := slack.NewVerifiedClient(context.Background(), "xoxb-test", slack.WithHttpClient(httpClient))
+		assert.NoError(t, err)
+
+		info, err := c.TokenInfo(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "T1", info.TeamID)
+		httpClient.AssertNumberOfCalls(t, "Do", 1)
+	})
+
+	t.Run("verified client failed with auth error", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":false,"error":"invalid_auth"}`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		c, err := slack.NewVerifiedClient(context.Background(), "xoxb-test", slack.WithHttpClient(httpClient))
+		assert.Nil(t, c)
+		assert.Equal(t, "token verification failed: slack respond with error: invalid_auth", err.Error())
+	})
+
+	t.Run("cached auth error is dropped after successful call", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":false,"error":"account_inactive"}`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":true}`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(okResponse(), nil).Once()
+
+		c := slack.NewClient(
+			"xoxb-test",
+			slack.WithTokenVerification(context.Background()),
+			slack.WithHttpClient(httpClient),
+		)
+
+		_, err := c.TokenInfo(context.Background())
+		assert.Error(t, err)
+
+		_, err = c.SendRequest(context.Background(), http.MethodPost, "chat.postMessage", nil)
+		assert.NoError(t, err)
+
+		info, err := c.TokenInfo(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "T1", info.TeamID)
+		httpClient.AssertNumberOfCalls(t, "Do", 3)
+	})
+
+	t.Run("lazy introspection is cached", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(okResponse(), nil).Once()
+
+		c := slack.NewClient("xoxp-test", slack.WithHttpClient(httpClient))
+		httpClient.AssertNumberOfCalls(t, "Do", 0)
+
+		for i := 0; i < 2; i++ {
+			info, err := c.TokenInfo(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, slack.TokenTypeUser, info.Type)
+		}
+		httpClient.AssertNumberOfCalls(t, "Do", 1)
+	})
+}
@@ -0,0 +1,146 @@
+// Package slack - Block Kit interactive elements
+package slack
+
+const (
+	// ElementButton type of buttons
+	ElementButton = "button"
+
+	// ElementPlainTextInput type of plain text inputs
+	ElementPlainTextInput = "plain_text_input"
+
+	// ElementStaticSelect type of selects with options defined in the element
+	ElementStaticSelect = "static_select"
+
+	// ElementExternalSelect type of selects with options loaded by block_suggestion interactions
+	ElementExternalSelect = "external_select"
+
+	// ElementUsersSelect type of user selects
+	ElementUsersSelect = "users_select"
+
+	// ElementConversationsSelect type of conversation selects
+	ElementConversationsSelect = "conversations_select"
+
+	// ElementChannelsSelect type of public channel selects
+	ElementChannelsSelect = "channels_select"
+
+	// ElementDatePicker type of date pickers
+	ElementDatePicker = "datepicker"
+)
+
+type (
+	// ButtonElement interactive button
+	ButtonElement struct {
+		// Type always button
+		Type string `json:"type"`
+
+		// ActionID identifier of the element in interactions
+		ActionID string `json:"action_id,omitempty"`
+
+		// Text text of the button
+		Text *TextObject `json:"text"`
+
+		// Value value sent with the interaction
+		Value string `json:"value,omitempty"`
+
+		// Url url to open in the browser on click
+		Url string `json:"url,omitempty"`
+
+		// Style primary or danger
+		Style string `json:"style,omitempty"`
+	}
+
+	// PlainTextInputElement text input
+	PlainTextInputElement struct {
+		// Type always plain_text_input
+		Type string `json:"type"`
+
+		// ActionID identifier of the element in the view state
+		ActionID string `json:"action_id,omitempty"`
+
+		// Placeholder text shown in the empty input
+		Placeholder *TextObject `json:"placeholder,omitempty"`
+
+		// InitialValue initial text of the input
+		InitialValue string `json:"initial_value,omitempty"`
+
+		// Multiline make the input multiline
+		Multiline bool `json:"multiline,omitempty"`
+
+		// MinLength minimum length of the text
+		MinLength int `json:"min_length,omitempty"`
+
+		// MaxLength maximum length of the text
+		MaxLength int `json:"max_length,omitempty"`
+	}
+
+	// SelectElement select menu of any of the select types
+	SelectElement struct {
+		// Type one of select element types, e.g. ElementStaticSelect
+		Type string `json:"type"`
+
+		// ActionID identifier of the element in interactions and the view state
+		ActionID string `json:"action_id,omitempty"`
+
+		// Placeholder text shown when nothing is selected
+		Placeholder *TextObject `json:"placeholder,omitempty"`
+
+		// Options options of a static select
+		Options []Option `json:"options,omitempty"`
+
+		// OptionGroups option groups of a static select, used instead of options
+		OptionGroups []OptionGroup `json:"option_groups,omitempty"`
+
+		// InitialOption initially selected option of a static or external select
+		InitialOption *Option `json:"initial_option,omitempty"`
+
+		// MinQueryLength characters to type before an external select requests options
+		MinQueryLength *int `json:"min_query_length,omitempty"`
+
+		// InitialUser initially selected user of a users select
+		InitialUser string `json:"initial_user,omitempty"`
+
+		// InitialConversation initially selected conversation of a conversations select
+		InitialConversation string `json:"initial_conversation,omitempty"`
+
+		// InitialChannel initially selected channel of a channels select
+		InitialChannel string `json:"initial_channel,omitempty"`
+
+		// ResponseURLEnabled generate response url for the selected conversation in view submissions
+		ResponseURLEnabled bool `json:"response_url_enabled,omitempty"`
+	}
+
+	// DatePickerElement date picker
+	DatePickerElement struct {
+		// Type always datepicker
+		Type string `json:"type"`
+
+		// ActionID identifier of the element in interactions and the view state
+		ActionID string `json:"action_id,omitempty"`
+
+		// Placeholder text shown when no date is selected
+		Placeholder *TextObject `json:"placeholder,omitempty"`
+
+		// InitialDate initially selected date, YYYY-MM-DD
+		InitialDate string `json:"initial_date,omitempty"`
+	}
+)
+
+// NewButtonElement makes a button
+func NewButtonElement(actionID, text, value string) *ButtonElement {
+	return &ButtonElement{Type: ElementButton, ActionID: actionID, Text: PlainText(text), Value: value}
+}
+
+// NewPlainTextInputElement makes a plain text input
+func NewPlainTextInputElement(actionID string) *PlainTextInputElement {
+	return &PlainTextInputElement{Type: ElementPlainTextInput, ActionID: actionID}
+}
+
+// NewSelectElement makes a select menu of the type, e.g. ElementStaticSelect
+func NewSelectElement(selectType, actionID string, options ...Option) *SelectElement {
+	return &SelectElement{Type: selectType, ActionID: actionID, Options: options}
+}
+
+// NewDatePickerElement makes a date picker
+func NewDatePickerElement(actionID string) *DatePickerElement {
+	return &DatePickerElement{Type: ElementDatePicker, ActionID: actionID}
+}
@@ -0,0 +1,63 @@
+// Package slack - Block Kit composition objects
+package slack
+
+const (
+	// TextPlain type of plain text objects
+	TextPlain = "plain_text"
+
+	// TextMarkdown type of text objects formatted with mrkdwn
+	TextMarkdown = "mrkdwn"
+)
+
+type (
+	// TextObject Block Kit text object
+	TextObject struct {
+		// Type TextPlain or TextMarkdown
+		Type string `json:"type"`
+
+		// Text the text itself
+		Text string `json:"text"`
+
+		// Emoji escape emojis into the colon format, only for plain text
+		Emoji *bool `json:"emoji,omitempty"`
+
+		// Verbatim disable automatic conversion of urls and mentions, only for mrkdwn
+		Verbatim *bool `json:"verbatim,omitempty"`
+	}
+
+	// Option an item of select menus, checkboxes and radio buttons
+	Option struct {
+		// Text text shown in the option
+		Text *TextObject `json:"text"`
+
+		// Value value sent to the app when the option is chosen
+		Value string `json:"value"`
+
+		// Description description shown below the text
+		Description *TextObject `json:"description,omitempty"`
+	}
+
+	// OptionGroup group of options of a select menu
+	OptionGroup struct {
+		// Label label shown above the group
+		Label *TextObject `json:"label"`
+
+		// Options options of the group
+		Options []Option `json:"options"`
+	}
+)
+
+// PlainText makes a plain text object
+func PlainText(text string) *TextObject {
+	return &TextObject{Type: TextPlain, Text: text}
+}
+
+// MarkdownText makes a text object formatted with mrkdwn
+func MarkdownText(text string) *TextObject {
+	return &TextObject{Type: TextMarkdown, Text: text}
+}
+
+// NewOption makes an option with plain text
+func NewOption(text, value string) Option {
+	return Option{Text: PlainText(text), Value: value}
+}
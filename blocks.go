@@ -0,0 +1,245 @@
+// Package slack - Block Kit layout blocks
+package slack
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	// BlockSection type of section blocks
+	BlockSection = "section"
+
+	// BlockDivider type of divider blocks
+	BlockDivider = "divider"
+
+	// BlockHeader type of header blocks
+	BlockHeader = "header"
+
+	// BlockContext type of context blocks
+	BlockContext = "context"
+
+	// BlockActions type of actions blocks
+	BlockActions = "actions"
+
+	// BlockInput type of input blocks
+	BlockInput = "input"
+)
+
+var blockFactories = map[string]func() Block{
+	BlockSection: func() Block { return &SectionBlock{} },
+	BlockDivider: func() Block { return &DividerBlock{} },
+	BlockHeader:  func() Block { return &HeaderBlock{} },
+	BlockContext: func() Block { return &ContextBlock{} },
+	BlockActions: func() Block { return &ActionsBlock{} },
+	BlockInput:   func() Block { return &InputBlock{} },
+}
+
+type (
+	// Block Block Kit layout block
+	Block interface {
+		// BlockType type of the block
+		BlockType() string
+	}
+
+	// Blocks list of layout blocks. Known blocks are decoded into pointers to their types, e.g. *SectionBlock,
+	// others into *RawBlock. Elements of blocks are decoded into maps
+	Blocks []Block
+
+	// RawBlock block of a type without a dedicated struct, kept as is
+	RawBlock struct {
+		// Type type of the block
+		Type string
+
+		// Data the whole block json
+		Data json.RawMessage
+	}
+
+	// SectionBlock text with optional fields and an accessory element
+	SectionBlock struct {
+		// Type always section
+		Type string `json:"type"`
+
+		// BlockID unique identifier of the block
+		BlockID string `json:"block_id,omitempty"`
+
+		// Text text of the section
+		Text *TextObject `json:"text,omitempty"`
+
+		// Fields texts shown in two columns
+		Fields []*TextObject `json:"fields,omitempty"`
+
+		// Accessory element shown next to the text, e.g. *ButtonElement
+		Accessory interface{} `json:"accessory,omitempty"`
+	}
+
+	// DividerBlock horizontal line between blocks
+	DividerBlock struct {
+		// Type always divider
+		Type string `json:"type"`
+
+		// BlockID unique identifier of the block
+		BlockID string `json:"block_id,omitempty"`
+	}
+
+	// HeaderBlock larger bold text
+	HeaderBlock struct {
+		// Type always header
+		Type string `json:"type"`
+
+		// BlockID unique identifier of the block
+		BlockID string `json:"block_id,omitempty"`
+
+		// Text plain text of the header
+		Text *TextObject `json:"text"`
+	}
+
+	// ContextBlock small texts and images
+	ContextBlock struct {
+		// Type always context
+		Type string `json:"type"`
+
+		// BlockID unique identifier of the block
+		BlockID string `json:"block_id,omitempty"`
+
+		// Elements text objects and image elements
+		Elements []interface{} `json:"elements"`
+	}
+
+	// ActionsBlock interactive elements, e.g. buttons and selects
+	ActionsBlock struct {
+		// Type always actions
+		Type string `json:"type"`
+
+		// BlockID unique identifier of the block
+		BlockID string `json:"block_id,omitempty"`
+
+		// Elements interactive elements
+		Elements []interface{} `json:"elements"`
+	}
+
+	// InputBlock labeled input element collecting data in views
+	InputBlock struct {
+		// Type always input
+		Type string `json:"type"`
+
+		// BlockID unique identifier of the block, key of its values in the view state
+		BlockID string `json:"block_id,omitempty"`
+
+		// Label label of the input
+		Label *TextObject `json:"label"`
+
+		// Element input element, e.g. *PlainTextInputElement
+		Element interface{} `json:"element"`
+
+		// Hint hint shown below the input
+		Hint *TextObject `json:"hint,omitempty"`
+
+		// Optional allow to submit the view without a value
+		Optional bool `json:"optional,omitempty"`
+
+		// DispatchAction send block_actions interaction on value change
+		DispatchAction bool `json:"dispatch_action,omitempty"`
+	}
+)
+
+// NewSectionBlock makes a section block
+func NewSectionBlock(blockID string, text *TextObject) *SectionBlock {
+	return &SectionBlock{Type: BlockSection, BlockID: blockID, Text: text}
+}
+
+// NewDividerBlock makes a divider block
+func NewDividerBlock() *DividerBlock {
+	return &DividerBlock{Type: BlockDivider}
+}
+
+// NewHeaderBlock makes a header block
+func NewHeaderBlock(text string) *HeaderBlock {
+	return &HeaderBlock{Type: BlockHeader, Text: PlainText(text)}
+}
+
+// NewContextBlock makes a context block
+func NewContextBlock(blockID string, elements ...interface{}) *ContextBlock {
+	return &ContextBlock{Type: BlockContext, BlockID: blockID, Elements: elements}
+}
+
+// NewActionsBlock makes an actions block
+func NewActionsBlock(blockID string, elements ...interface{}) *ActionsBlock {
+	return &ActionsBlock{Type: BlockActions, BlockID: blockID, Elements: elements}
+}
+
+// NewInputBlock makes an input block
+func NewInputBlock(blockID, label string, element interface{}) *InputBlock {
+	return &InputBlock{Type: BlockInput, BlockID: blockID, Label: PlainText(label), Element: element}
+}
+
+// BlockType implementation
+func (b *RawBlock) BlockType() string { return b.Type }
+
+// BlockType implementation
+func (b *SectionBlock) BlockType() string { return BlockSection }
+
+// BlockType implementation
+func (b *DividerBlock) BlockType() string { return BlockDivider }
+
+// BlockType implementation
+func (b *HeaderBlock) BlockType() string { return BlockHeader }
+
+// BlockType implementation
+func (b *ContextBlock) BlockType() string { return BlockContext }
+
+// BlockType implementation
+func (b *ActionsBlock) BlockType() string { return BlockActions }
+
+// BlockType implementation
+func (b *InputBlock) BlockType() string { return BlockInput }
+
+// MarshalJSON implementation
+func (b *RawBlock) MarshalJSON() ([]byte, error) {
+	return b.Data, nil
+}
+
+// MarshalJSON implementation, nil blocks are marshaled as an empty list, slack rejects null
+func (b Blocks) MarshalJSON() ([]byte, error) {
+	if b == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal([]Block(b))
+}
+
+// UnmarshalJSON implementation
+func (b *Blocks) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	blocks := make(Blocks, 0, len(raw))
+	for _, data := range raw {
+		var head struct {
+			Type string `json:"type"`
+		}
+
+		if err := json.Unmarshal(data, &head); err != nil {
+			return err
+		}
+
+		factory, ok := blockFactories[head.Type]
+		if !ok {
+			blocks = append(blocks, &RawBlock{Type: head.Type, Data: data})
+			continue
+		}
+
+		block := factory()
+		if err := json.Unmarshal(data, block); err != nil {
+			return fmt.Errorf("can't unmarshal %s block: %w", head.Type, err)
+		}
+
+		blocks = append(blocks, block)
+	}
+
+	*b = blocks
+
+	return nil
+}
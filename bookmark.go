@@ -0,0 +1,184 @@
+// Package slack - bookmarks
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+const bookmarkTypeLink = "link"
+
+type (
+	// Bookmark entity
+	Bookmark struct {
+		// ID bookmark identifier
+		ID string `json:"id"`
+
+		// ChannelID channel the bookmark belongs to
+		ChannelID string `json:"channel_id"`
+
+		// Title bookmark title
+		Title string `json:"title"`
+
+		// Link url the bookmark points to
+		Link string `json:"link"`
+
+		// Emoji emoji shown next to the bookmark title
+		Emoji string `json:"emoji"`
+
+		// IconUrl url of the bookmark icon
+		IconUrl string `json:"icon_url"`
+
+		// Type bookmark type, link for link bookmarks
+		Type string `json:"type"`
+
+		// EntityID id of the entity the bookmark points to, e.g. a file or a message
+		EntityID string `json:"entity_id"`
+
+		// DateCreated a unix timestamp when the bookmark was created
+		DateCreated int64 `json:"date_created"`
+
+		// DateUpdated a unix timestamp when the bookmark was last updated
+		DateUpdated int64 `json:"date_updated"`
+
+		// Rank position of the bookmark in the bookmarks bar
+		Rank string `json:"rank"`
+
+		// LastUpdatedByUserID user who last updated the bookmark
+		LastUpdatedByUserID string `json:"last_updated_by_user_id"`
+
+		// LastUpdatedByTeamID team of the user who last updated the bookmark
+		LastUpdatedByTeamID string `json:"last_updated_by_team_id"`
+
+		// Team workspace of the bookmark channel
+		Team string `json:"team"`
+
+		// EnterpriseID Enterprise Grid organization of the bookmark channel, empty outside of Enterprise Grid
+		EnterpriseID string `json:"enterprise_id"`
+
+		// UserTeam workspace of the user who created the bookmark
+		UserTeam string `json:"user_team"`
+
+		// SourceTeam workspace the bookmark was created from
+		SourceTeam string `json:"source_team"`
+
+		// ShortcutID id of the shortcut the bookmark points to
+		ShortcutID string `json:"shortcut_id"`
+
+		// AppID id of the app created the bookmark
+		AppID string `json:"app_id"`
+	}
+
+	bookmarkRequest struct {
+		ChannelID  string `json:"channel_id"`
+		BookmarkID string `json:"bookmark_id,omitempty"`
+		Title      string `json:"title,omitempty"`
+		Type       string `json:"type,omitempty"`
+		Link       string `json:"link,omitempty"`
+		Emoji      string `json:"emoji,omitempty"`
+	}
+
+	bookmarkApiResponse struct {
+		// Ok indicates success or failure
+		Ok bool `json:"ok"`
+
+		// Error short machine-readable error code
+		Error string `json:"error"`
+
+		// Bookmark added or edited bookmark
+		Bookmark Bookmark `json:"bookmark"`
+
+		// Bookmarks channel bookmarks
+		Bookmarks []Bookmark `json:"bookmarks"`
+	}
+)
+
+func addBookmark(ctx context.Context, c *client, channel, title, link string, opts ...BookmarkOption) (Bookmark, error) {
+	req := bookmarkRequest{
+		ChannelID: channel,
+		Title:     title,
+		Type:      bookmarkTypeLink,
+		Link:      link,
+	}
+
+	for _, opt := range opts {
+		opt.apply(&req)
+	}
+
+	resp, err := sendBookmarkRequest(ctx, c, "bookmarks.add", req)
+	if err != nil {
+		return Bookmark{}, err
+	}
+
+	return resp.Bookmark, nil
+}
+
+func editBookmark(ctx context.Context, c *client, channel, bookmarkID string, opts ...BookmarkOption) (Bookmark, error) {
+	req := bookmarkRequest{
+		ChannelID:  channel,
+		BookmarkID: bookmarkID,
+	}
+
+	for _, opt := range opts {
+		opt.apply(&req)
+	}
+
+	resp, err := sendBookmarkRequest(ctx, c, "bookmarks.edit", req)
+	if err != nil {
+		return Bookmark{}, err
+	}
+
+	return resp.Bookmark, nil
+}
+
+func removeBookmark(ctx context.Context, c *client, channel, bookmarkID string) error {
+	_, err := sendBookmarkRequest(ctx, c, "bookmarks.remove", bookmarkRequest{
+		ChannelID:  channel,
+		BookmarkID: bookmarkID,
+	})
+
+	return err
+}
+
+func listBookmarks(ctx context.Context, c *client, channel string) ([]Bookmark, error) {
+	respBody, err := c.get(ctx, "bookmarks.list?"+url.Values{"channel_id": {channel}}.Encode())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := decodeBookmarkResponse(respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Bookmarks, nil
+}
+
+func sendBookmarkRequest(ctx context.Context, c *client, method string, req bookmarkRequest) (bookmarkApiResponse, error) {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return bookmarkApiResponse{}, fmt.Errorf("can't marshal request: %w", err)
+	}
+
+	respBody, err := c.post(ctx, method, data)
+	if err != nil {
+		return bookmarkApiResponse{}, err
+	}
+
+	return decodeBookmarkResponse(respBody)
+}
+
+func decodeBookmarkResponse(respBody []byte) (bookmarkApiResponse, error) {
+	var resp bookmarkApiResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return bookmarkApiResponse{}, fmt.Errorf("can't unmarshal response: %w", err)
+	}
+
+	if !resp.Ok {
+		return bookmarkApiResponse{}, fmt.Errorf("slack respond with error: %s", resp.Error)
+	}
+
+	return resp, nil
+}
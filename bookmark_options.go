@@ -0,0 +1,48 @@
+// Package slack - bookmark options
+package slack
+
+type (
+	// BookmarkOption to apply optional parameters to added or edited bookmark
+	BookmarkOption interface {
+		apply(req *bookmarkRequest)
+	}
+
+	bookmarkTitle struct {
+		title string
+	}
+
+	bookmarkLink struct {
+		link string
+	}
+
+	bookmarkEmoji struct {
+		emoji string
+	}
+)
+
+// BookmarkTitle sets the bookmark title
+func BookmarkTitle(title string) BookmarkOption {
+	return &bookmarkTitle{title: title}
+}
+
+func (opt *bookmarkTitle) apply(req *bookmarkRequest) {
+	req.Title = opt.title
+}
+
+// BookmarkLink sets the url the bookmark points to
+func BookmarkLink(link string) BookmarkOption {
+	return &bookmarkLink{link: link}
+}
+
+func (opt *bookmarkLink) apply(req *bookmarkRequest) {
+	req.Link = opt.link
+}
+
+// BookmarkEmoji sets the emoji shown next to the bookmark title
+func BookmarkEmoji(emoji string) BookmarkOption {
+	return &bookmarkEmoji{emoji: emoji}
+}
+
+func (opt *bookmarkEmoji) apply(req *bookmarkRequest) {
+	req.Emoji = opt.emoji
+}
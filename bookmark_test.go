@@ -0,0 +1,110 @@
+package slack_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-slack"
+)
+
+func TestClient_AddBookmark(t *testing.T) {
+	baseUrl := "http://test.slack.com/api"
+
+	httpClient := new(slack.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, baseUrl+"/"+"bookmarks.add", req.URL.String())
+
+		request, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"channel_id":"C1","title":"Runbook","type":"link",`+
+			`"link":"https://wiki/runbook","emoji":":book:"}`, string(request))
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":true,"bookmark":{"id":"Bk1","channel_id":"C1"}}`))),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	c := slack.NewClient("test_token", slack.WithBaseUrl(baseUrl), slack.WithHttpClient(httpClient))
+
+	bookmark, err := c.AddBookmark(
+		context.Background(),
+		"C1",
+		"Runbook",
+		"https://wiki/runbook",
+		slack.BookmarkEmoji(":book:"),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, slack.Bookmark{ID: "Bk1", ChannelID: "C1"}, bookmark)
+}
+
+func TestClient_EditBookmark(t *testing.T) {
+	httpClient := new(slack.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		request, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"channel_id":"C1","bookmark_id":"Bk1","title":"New","link":"https://new"}`, string(request))
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":true,"bookmark":{"id":"Bk1","title":"New"}}`))),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	c := slack.NewClient("test_token", slack.WithHttpClient(httpClient))
+
+	bookmark, err := c.EditBookmark(
+		context.Background(),
+		"C1",
+		"Bk1",
+		slack.BookmarkTitle("New"),
+		slack.BookmarkLink("https://new"),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, "New", bookmark.Title)
+}
+
+func TestClient_RemoveBookmark(t *testing.T) {
+	httpClient := new(slack.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":false,"error":"not_found"}`))),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	c := slack.NewClient("test_token", slack.WithHttpClient(httpClient))
+
+	err := c.RemoveBookmark(context.Background(), "C1", "Bk1")
+	assert.Error(t, err)
+	assert.Equal(t, "slack respond with error: not_found", err.Error())
+}
+
+func TestClient_ListBookmarks(t *testing.T) {
+	baseUrl := "http://test.slack.com/api"
+
+	httpClient := new(slack.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, http.MethodGet, req.Method)
+		assert.Equal(t, baseUrl+"/"+"bookmarks.list?channel_id=C1", req.URL.String())
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":true,"bookmarks":[{"id":"Bk1"},{"id":"Bk2"}]}`))),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	c := slack.NewClient("test_token", slack.WithBaseUrl(baseUrl), slack.WithHttpClient(httpClient))
+
+	bookmarks, err := c.ListBookmarks(context.Background(), "C1")
+	assert.NoError(t, err)
+	assert.Equal(t, []slack.Bookmark{{ID: "Bk1"}, {ID: "Bk2"}}, bookmarks)
+}
@@ -0,0 +1,261 @@
+// Package slack - generic api calls
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	defaultCallRetries = 3
+	defaultCallBackoff = time.Second
+)
+
+// readSuffixes suffixes of read methods, they don't accept json bodies and are safe to retry
+var readSuffixes = []string{".info", ".list", ".history", ".replies", ".members", ".lookupByEmail", ".get"}
+
+type (
+	// APIError slack respond with ok false
+	APIError struct {
+		// Code error code, e.g. channel_not_found
+		Code string
+
+		// Messages detailed error messages, e.g. of invalid blocks
+		Messages []string
+
+		// Warnings warnings of the response
+		Warnings []string
+	}
+
+	// Meta metadata of a call response
+	Meta struct {
+		// Warnings warnings of the response, e.g. missing_charset or superfluous_charset
+		Warnings []string
+
+		// NextCursor cursor of the next page, empty when there are no more pages. Read from response metadata or the
+		// top level of the response
+		NextCursor string
+	}
+
+	envelope struct {
+		Ok               bool   `json:"ok"`
+		Error            string `json:"error"`
+		Warning          string `json:"warning"`
+		NextCursor       string `json:"next_cursor"`
+		ResponseMetadata struct {
+			NextCursor string   `json:"next_cursor"`
+			Warnings   []string `json:"warnings"`
+			Messages   []string `json:"messages"`
+		} `json:"response_metadata"`
+	}
+)
+
+// Error implementation
+func (e *APIError) Error() string {
+	return "slack respond with error: " + e.Code
+}
+
+// Call calls any slack api method, e.g. Call[Req, Resp](ctx, c, "conversations.info", req). The request is encoded
+// as json or form depending on the method, the response is checked for errors and decoded into Resp. Rate limited
+// requests are retried, server errors are retried only for idempotent methods, see Idempotent. Use it for methods the
+// client doesn't cover
+func Call[Req, Resp any](ctx context.Context, c Client, method string, req Req, opts ...CallOption) (Resp, error) {
+	resp, _, err := CallWithMeta[Req, Resp](ctx, c, method, req, opts...)
+
+	return resp, err
+}
+
+// CallWithMeta is Call also returning warnings and pagination metadata of the response
+func CallWithMeta[Req, Resp any](ctx context.Context, c Client, method string, req Req, opts ...CallOption) (Resp, Meta, error) {
+	var resp Resp
+
+	cfg := newCallConfig(method, opts)
+
+	data, err := encodeCall(req, cfg.form, cfg.params)
+	if err != nil {
+		return resp, Meta{}, err
+	}
+
+	body, err := cfg.send(ctx, c, method, data)
+	if err != nil {
+		return resp, Meta{}, err
+	}
+
+	var env envelope
+	if err = json.Unmarshal(body, &env); err != nil {
+		return resp, Meta{}, fmt.Errorf("can't unmarshal response: %w", err)
+	}
+
+	meta := Meta{Warnings: env.warnings(), NextCursor: env.nextCursor()}
+
+	if !env.Ok {
+		return resp, meta, &APIError{Code: env.Error, Messages: env.ResponseMetadata.Messages, Warnings: meta.Warnings}
+	}
+
+	if err = json.Unmarshal(body, &resp); err != nil {
+		return resp, meta, fmt.Errorf("can't unmarshal response: %w", err)
+	}
+
+	return resp, meta, nil
+}
+
+// CallPages calls a cursor paginated method and passes every page to the function until there are no more pages
+// or the function returns an error. The cursor is added to the request parameters
+func CallPages[Req, Resp any](
+	ctx context.Context,
+	c Client,
+	method string,
+	req Req,
+	fn func(page Resp) error,
+	opts ...CallOption,
+) error {
+	cursor := ""
+	for {
+		page, meta, err := CallWithMeta[Req, Resp](ctx, c, method, req, append(opts, withCursor(cursor))...)
+		if err != nil {
+			return err
+		}
+
+		if err = fn(page); err != nil {
+			return err
+		}
+
+		if len(meta.NextCursor) == 0 {
+			return nil
+		}
+
+		cursor = meta.NextCursor
+	}
+}
+
+// send sends the request retrying rate limited errors and server errors of idempotent methods. A server error of
+// other methods doesn't mean the request wasn't handled, e.g. a message can be posted twice
+func (cfg *callConfig) send(ctx context.Context, c Client, method string, data []byte) ([]byte, error) {
+	backoff := cfg.backoff
+	for attempt := 0; ; attempt++ {
+		var (
+			body []byte
+			err  error
+		)
+
+		if cfg.form {
+			body, err = c.SendFormRequest(ctx, method, data)
+		} else {
+			body, err = c.SendRequest(ctx, http.MethodPost, method, data)
+		}
+
+		if err == nil {
+			return body, nil
+		}
+
+		delay := backoff
+
+		var (
+			rateLimited *RateLimitedError
+			statusErr   *StatusCodeError
+		)
+
+		switch {
+		case errors.As(err, &rateLimited):
+			delay = rateLimited.RetryAfter
+		case cfg.idempotent && errors.As(err, &statusErr) && statusErr.StatusCode >= http.StatusInternalServerError:
+			backoff *= 2
+		default:
+			return nil, err
+		}
+
+		if attempt >= cfg.retries {
+			return nil, err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// encodeCall encodes the request as json or form. Strings of form values are kept as is, other values are json
+func encodeCall(req interface{}, form bool, params url.Values) ([]byte, error) {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("can't marshal request: %w", err)
+	}
+
+	// nil request is sent as an empty json object or an empty form
+	if string(data) == "null" {
+		data = []byte("{}")
+	}
+
+	if !form && len(params) == 0 {
+		return data, nil
+	}
+
+	fields := map[string]json.RawMessage{}
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("can't encode request, it must be a json object: %w", err)
+	}
+
+	for key := range params {
+		value, _ := json.Marshal(params.Get(key))
+		fields[key] = value
+	}
+
+	if !form {
+		return json.Marshal(fields)
+	}
+
+	values := url.Values{}
+	for key, raw := range fields {
+		var str string
+		switch {
+		case string(raw) == "null":
+			continue
+		case json.Unmarshal(raw, &str) == nil:
+			values.Set(key, str)
+		default:
+			values.Set(key, string(raw))
+		}
+	}
+
+	return []byte(values.Encode()), nil
+}
+
+// isReadMethod reports whether the method only reads data
+func isReadMethod(method string) bool {
+	for _, suffix := range readSuffixes {
+		if strings.HasSuffix(method, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// nextCursor returns cursor of the next page, a few methods, e.g. admin.conversations.search, return it at the top
+// level instead of response metadata
+func (e envelope) nextCursor() string {
+	if len(e.ResponseMetadata.NextCursor) > 0 {
+		return e.ResponseMetadata.NextCursor
+	}
+
+	return e.NextCursor
+}
+
+func (e envelope) warnings() []string {
+	var warnings []string
+	if len(e.Warning) > 0 {
+		warnings = strings.Split(e.Warning, ",")
+	}
+
+	return append(warnings, e.ResponseMetadata.Warnings...)
+}
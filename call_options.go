@@ -0,0 +1,110 @@
+// Package slack - call options
+package slack
+
+import (
+	"net/url"
+	"time"
+)
+
+type (
+	// CallOption to use optional parameters in generic calls
+	CallOption interface {
+		apply(cfg *callConfig)
+	}
+
+	callConfig struct {
+		form       bool
+		idempotent bool
+		retries    int
+		backoff    time.Duration
+		params     url.Values
+	}
+
+	callEncoding struct {
+		form bool
+	}
+
+	callIdempotent struct{}
+
+	callRetries struct {
+		retries int
+		backoff time.Duration
+	}
+
+	callParam struct {
+		key string
+		val string
+	}
+)
+
+func newCallConfig(method string, opts []CallOption) *callConfig {
+	read := isReadMethod(method)
+
+	cfg := &callConfig{
+		form:       read,
+		idempotent: read,
+		retries:    defaultCallRetries,
+		backoff:    defaultCallBackoff,
+		params:     url.Values{},
+	}
+
+	for _, opt := range opts {
+		opt.apply(cfg)
+	}
+
+	return cfg
+}
+
+// JSONEncoded sends the request as json, the default for methods except read ones, e.g. *.info, *.list or *.history
+func JSONEncoded() CallOption {
+	return &callEncoding{form: false}
+}
+
+// FormEncoded sends the request as form, the default for read methods, e.g. *.info, *.list or *.history
+func FormEncoded() CallOption {
+	return &callEncoding{form: true}
+}
+
+func (opt *callEncoding) apply(cfg *callConfig) {
+	cfg.form = opt.form
+}
+
+// Idempotent marks the method safe to repeat, so its server errors are retried. Read methods, e.g. *.info, *.list or
+// *.history, are idempotent by default
+func Idempotent() CallOption {
+	return &callIdempotent{}
+}
+
+func (opt *callIdempotent) apply(cfg *callConfig) {
+	cfg.idempotent = true
+}
+
+// Retries sets number of retries of rate limited and server errors and delay before the first server error retry,
+// doubled for each next one. Rate limited requests are retried after the delay slack asks for, server errors only
+// for idempotent methods. 3 retries starting with 1 second by default
+func Retries(retries int, backoff time.Duration) CallOption {
+	return &callRetries{retries: retries, backoff: backoff}
+}
+
+func (opt *callRetries) apply(cfg *callConfig) {
+	cfg.retries = opt.retries
+	cfg.backoff = opt.backoff
+}
+
+// CallParam adds a parameter to the request overriding the field of the request with the same name
+func CallParam(key, val string) CallOption {
+	return &callParam{key: key, val: val}
+}
+
+func withCursor(cursor string) CallOption {
+	return &callParam{key: "cursor", val: cursor}
+}
+
+func (opt *callParam) apply(cfg *callConfig) {
+	if len(opt.val) == 0 {
+		cfg.params.Del(opt.key)
+		return
+	}
+
+	cfg.params.Set(opt.key, opt.val)
+}
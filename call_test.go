@@ -0,0 +1,328 @@
+package slack_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-slack"
+)
+
+type (
+	testConversationInfoReq struct {
+		Channel       string `json:"channel"`
+		IncludeLocale bool   `json:"include_locale,omitempty"`
+	}
+
+	testConversationInfoResp struct {
+		Channel struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"channel"`
+	}
+
+	testPostReq struct {
+		Channel string        `json:"channel"`
+		Text    string        `json:"text"`
+		Blocks  []slack.Block `json:"blocks,omitempty"`
+	}
+
+	testPostResp struct {
+		Channel   string `json:"channel"`
+		Timestamp string `json:"ts"`
+	}
+
+	testListResp struct {
+		Members []string `json:"members"`
+	}
+)
+
+func testResponse(body string, statusCode int) *http.Response {
+	return &http.Response{Body: ioutil.NopCloser(bytes.NewReader([]byte(body))), StatusCode: statusCode}
+}
+
+func TestCall(t *testing.T) {
+	t.Run("json encoded method", func(t *testing.T) {
+		baseUrl := "http://test.slack.com/api"
+
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, baseUrl+"/"+"chat.postMessage", req.URL.String())
+			assert.Equal(t, "application/json; charset=utf-8", req.Header.Get("Content-Type"))
+
+			request, err := ioutil.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"channel":"C1","text":"hi","blocks":[{"type":"divider"}]}`, string(request))
+		}).Return(testResponse(`{"ok":true,"channel":"C1","ts":"1.2"}`, http.StatusOK), nil)
+
+		c := slack.NewClient("test_token", slack.WithBaseUrl(baseUrl), slack.WithHttpClient(httpClient))
+
+		resp, err := slack.Call[testPostReq, testPostResp](context.Background(), c, "chat.postMessage", testPostReq{
+			Channel: "C1",
+			Text:    "hi",
+			Blocks:  []slack.Block{slack.NewDividerBlock()},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, testPostResp{Channel: "C1", Timestamp: "1.2"}, resp)
+	})
+
+	t.Run("form encoded method", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, "application/x-www-form-urlencoded", req.Header.Get("Content-Type"))
+
+			request, err := ioutil.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, "channel=C1&include_locale=true", string(request))
+		}).Return(testResponse(`{"ok":true,"channel":{"id":"C1","name":"general"}}`, http.StatusOK), nil)
+
+		c := slack.NewClient("test_token", slack.WithHttpClient(httpClient))
+
+		resp, err := slack.Call[testConversationInfoReq, testConversationInfoResp](context.Background(), c,
+			"conversations.info", testConversationInfoReq{Channel: "C1", IncludeLocale: true})
+		assert.NoError(t, err)
+		assert.Equal(t, "general", resp.Channel.Name)
+	})
+
+	t.Run("nil request", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			method      string
+			opts        []slack.ClientOption
+			contentType string
+			body        string
+		}{
+			{
+				name:        "json",
+				method:      "dnd.endDnd",
+				contentType: "application/json; charset=utf-8",
+				body:        `{}`,
+			},
+			{
+				name:        "json scoped to team",
+				method:      "dnd.endDnd",
+				opts:        []slack.ClientOption{slack.WithTeam("T1")},
+				contentType: "application/json; charset=utf-8",
+				body:        `{"team_id":"T1"}`,
+			},
+			{
+				name:        "form",
+				method:      "dnd.info",
+				contentType: "application/x-www-form-urlencoded",
+				body:        ``,
+			},
+			{
+				name:        "form scoped to team",
+				method:      "dnd.info",
+				opts:        []slack.ClientOption{slack.WithTeam("T1")},
+				contentType: "application/x-www-form-urlencoded",
+				body:        `team_id=T1`,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				httpClient := new(slack.MockHTTPClient)
+				httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+					req, ok := args.Get(0).(*http.Request)
+					assert.True(t, ok)
+
+					assert.Equal(t, tt.contentType, req.Header.Get("Content-Type"))
+
+					request, err := ioutil.ReadAll(req.Body)
+					assert.NoError(t, err)
+					assert.Equal(t, tt.body, string(request))
+				}).Return(testResponse(`{"ok":true}`, http.StatusOK), nil)
+
+				c := slack.NewClient("test_token", append(tt.opts, slack.WithHttpClient(httpClient))...)
+
+				_, err := slack.Call[interface{}, map[string]interface{}](context.Background(), c, tt.method, nil)
+				assert.NoError(t, err)
+			})
+		}
+	})
+
+	t.Run("slack respond with error", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(testResponse(`{"ok":false,`+
+			`"error":"invalid_blocks","warning":"missing_charset",`+
+			`"response_metadata":{"messages":["[ERROR] must be a valid block"]}}`, http.StatusOK), nil)
+
+		c := slack.NewClient("test_token", slack.WithHttpClient(httpClient))
+
+		_, meta, err := slack.CallWithMeta[testPostReq, testPostResp](context.Background(), c, "chat.postMessage",
+			testPostReq{Channel: "C1"})
+
+		var apiErr *slack.APIError
+		assert.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, "slack respond with error: invalid_blocks", err.Error())
+		assert.Equal(t, []string{"[ERROR] must be a valid block"}, apiErr.Messages)
+		assert.Equal(t, []string{"missing_charset"}, apiErr.Warnings)
+		assert.Equal(t, []string{"missing_charset"}, meta.Warnings)
+	})
+
+	t.Run("server error is retried", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).
+			Return(testResponse(`internal error`, http.StatusInternalServerError), nil).Once()
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).
+			Return(testResponse(`{"ok":true,"channel":{"id":"C1","name":"general"}}`, http.StatusOK), nil).Once()
+
+		c := slack.NewClient("test_token", slack.WithHttpClient(httpClient))
+
+		resp, err := slack.Call[testConversationInfoReq, testConversationInfoResp](context.Background(), c,
+			"conversations.info", testConversationInfoReq{Channel: "C1"}, slack.Retries(1, time.Millisecond))
+		assert.NoError(t, err)
+		assert.Equal(t, "general", resp.Channel.Name)
+		httpClient.AssertNumberOfCalls(t, "Do", 2)
+	})
+
+	t.Run("server error of idempotent write is retried", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).
+			Return(testResponse(`internal error`, http.StatusInternalServerError), nil).Once()
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).
+			Return(testResponse(`{"ok":true}`, http.StatusOK), nil).Once()
+
+		c := slack.NewClient("test_token", slack.WithHttpClient(httpClient))
+
+		_, err := slack.Call[map[string]string, map[string]interface{}](context.Background(), c,
+			"conversations.setTopic", map[string]string{"channel": "C1", "topic": "news"},
+			slack.Idempotent(), slack.Retries(1, time.Millisecond))
+		assert.NoError(t, err)
+		httpClient.AssertNumberOfCalls(t, "Do", 2)
+	})
+
+	t.Run("server error of write is not retried", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).
+			Return(testResponse(`internal error`, http.StatusInternalServerError), nil)
+
+		c := slack.NewClient("test_token", slack.WithHttpClient(httpClient))
+
+		_, err := slack.Call[testPostReq, testPostResp](context.Background(), c, "chat.postMessage",
+			testPostReq{Channel: "C1"}, slack.Retries(1, time.Millisecond))
+
+		var statusErr *slack.StatusCodeError
+		assert.True(t, errors.As(err, &statusErr))
+		httpClient.AssertNumberOfCalls(t, "Do", 1)
+	})
+
+	t.Run("rate limited write is retried", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Header:     http.Header{"Retry-After": {"1"}},
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+			StatusCode: http.StatusTooManyRequests,
+		}, nil).Once()
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).
+			Return(testResponse(`{"ok":true,"channel":"C1","ts":"1.2"}`, http.StatusOK), nil).Once()
+
+		c := slack.NewClient("test_token", slack.WithHttpClient(httpClient))
+
+		resp, err := slack.Call[testPostReq, testPostResp](context.Background(), c, "chat.postMessage",
+			testPostReq{Channel: "C1"}, slack.Retries(1, time.Millisecond))
+		assert.NoError(t, err)
+		assert.Equal(t, "1.2", resp.Timestamp)
+		httpClient.AssertNumberOfCalls(t, "Do", 2)
+	})
+
+	t.Run("retries are exhausted", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).
+			Return(testResponse(`bad gateway`, http.StatusBadGateway), nil)
+
+		c := slack.NewClient("test_token", slack.WithHttpClient(httpClient))
+
+		_, err := slack.Call[testConversationInfoReq, testConversationInfoResp](context.Background(), c,
+			"conversations.info", testConversationInfoReq{Channel: "C1"}, slack.Retries(2, time.Millisecond))
+
+		var statusErr *slack.StatusCodeError
+		assert.True(t, errors.As(err, &statusErr))
+		assert.Equal(t, http.StatusBadGateway, statusErr.StatusCode)
+		httpClient.AssertNumberOfCalls(t, "Do", 3)
+	})
+
+	t.Run("client error is not retried", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).
+			Return(testResponse(`not found`, http.StatusNotFound), nil)
+
+		c := slack.NewClient("test_token", slack.WithHttpClient(httpClient))
+
+		_, err := slack.Call[testPostReq, testPostResp](context.Background(), c, "chat.postMessage",
+			testPostReq{Channel: "C1"}, slack.Retries(2, time.Millisecond))
+		assert.Error(t, err)
+		httpClient.AssertNumberOfCalls(t, "Do", 1)
+	})
+}
+
+func TestCallPages(t *testing.T) {
+	var requests []string
+
+	httpClient := new(slack.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		request, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		requests = append(requests, string(request))
+	}).Return(testResponse(`{"ok":true,"members":["U1","U2"],"response_metadata":{"next_cursor":"abc"}}`,
+		http.StatusOK), nil).Once()
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		request, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		requests = append(requests, string(request))
+	}).Return(testResponse(`{"ok":true,"members":["U3"],"response_metadata":{"next_cursor":""}}`,
+		http.StatusOK), nil).Once()
+
+	c := slack.NewClient("test_token", slack.WithHttpClient(httpClient))
+
+	var members []string
+	err := slack.CallPages(context.Background(), c, "conversations.members", map[string]string{"channel": "C1"},
+		func(page testListResp) error {
+			members = append(members, page.Members...)
+			return nil
+		}, slack.CallParam("limit", "2"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"U1", "U2", "U3"}, members)
+	assert.Equal(t, []string{"channel=C1&limit=2", "channel=C1&cursor=abc&limit=2"}, requests)
+}
+
+func TestCallPages_TopLevelCursor(t *testing.T) {
+	httpClient := new(slack.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).
+		Return(testResponse(`{"ok":true,"members":["U1"],"next_cursor":"abc"}`, http.StatusOK), nil).Once()
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).
+		Return(testResponse(`{"ok":true,"members":["U2"],"next_cursor":""}`, http.StatusOK), nil).Once()
+
+	c := slack.NewClient("test_token", slack.WithHttpClient(httpClient))
+
+	var members []string
+	err := slack.CallPages(context.Background(), c, "admin.conversations.search", map[string]string{},
+		func(page testListResp) error {
+			members = append(members, page.Members...)
+			return nil
+		})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"U1", "U2"}, members)
+	httpClient.AssertNumberOfCalls(t, "Do", 2)
+}
@@ -3,18 +3,34 @@ package slack
 
 //go:generate mockery -case=underscore -inpkg -name=Client
 //go:generate mockery -case=underscore -inpkg -name=HTTPClient
+//go:generate mockery -case=underscore -inpkg -name=Chat
+//go:generate mockery -case=underscore -inpkg -name=Users
+//go:generate mockery -case=underscore -inpkg -name=Reactions
+//go:generate mockery -case=underscore -inpkg -name=Pins
+//go:generate mockery -case=underscore -inpkg -name=Bookmarks
+//go:generate mockery -case=underscore -inpkg -name=Files
+//go:generate mockery -case=underscore -inpkg -name=Views
+//go:generate mockery -case=underscore -inpkg -name=Auth
+//go:generate mockery -case=underscore -inpkg -name=Teams
 
 import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"time"
 
 	"github.com/pkg/errors"
 )
 
-const defaultBaseUrl = "https://slack.com/api/"
+const (
+	defaultBaseUrl = "https://slack.com/api/"
+
+	contentTypeJSON = "application/json; charset=utf-8"
+	contentTypeForm = "application/x-www-form-urlencoded"
+)
 
 // HTTPClient interface to replace default http client
 type HTTPClient interface {
@@ -24,35 +40,200 @@ type HTTPClient interface {
 type (
 	// Client provides api to work with slack entities
 	Client interface {
+		Chat
+		Users
+		Reactions
+		Pins
+		Bookmarks
+		Files
+		Views
+		Auth
+		Teams
+
+		// SendRequest send http request to slack
+		SendRequest(ctx context.Context, method string, path string, data []byte) ([]byte, error)
+
+		// SendFormRequest send http post request with form encoded body to slack, read methods like *.info or *.list
+		// don't accept json bodies
+		SendFormRequest(ctx context.Context, path string, data []byte) ([]byte, error)
+	}
+
+	// Chat provides api to work with messages
+	Chat interface {
 		// PostMessage send Message to a channel
 		PostMessage(ctx context.Context, message string, channel string, opts ...MsgOption) (MessagePosted, error)
+	}
 
+	// Users provides api to work with users
+	Users interface {
 		// GetUserByEmail find a user with an email address.
 		GetUserByEmail(ctx context.Context, email string) (User, error)
+	}
 
-		// SendRequest send http request to slack
-		SendRequest(ctx context.Context, method string, path string, data []byte) ([]byte, error)
+	// Reactions provides api to work with reactions
+	Reactions interface {
+		// AddReaction adds a reaction (emoji) to a message
+		AddReaction(ctx context.Context, name string, msg MessageRef) error
+
+		// RemoveReaction removes a reaction from a message or a file
+		RemoveReaction(ctx context.Context, name string, item ItemRef) error
+
+		// GetReactions gets reactions for a message or a file
+		GetReactions(ctx context.Context, item ItemRef) (ReactedItem, error)
+
+		// ListReactions lists items reacted by a user, the authed one by default
+		ListReactions(ctx context.Context, opts ...ListOption) (ReactedItems, error)
+	}
+
+	// Pins provides api to work with pinned messages
+	Pins interface {
+		// AddPin pins a message to the channel
+		AddPin(ctx context.Context, msg MessageRef) error
+
+		// RemovePin un-pins a message from the channel
+		RemovePin(ctx context.Context, msg MessageRef) error
+
+		// ListPins lists items pinned to a channel
+		ListPins(ctx context.Context, channel string) ([]Pin, error)
+	}
+
+	// Bookmarks provides api to work with channel bookmarks
+	Bookmarks interface {
+		// AddBookmark adds a link bookmark to a channel
+		AddBookmark(ctx context.Context, channel, title, link string, opts ...BookmarkOption) (Bookmark, error)
+
+		// EditBookmark edits a channel bookmark
+		EditBookmark(ctx context.Context, channel, bookmarkID string, opts ...BookmarkOption) (Bookmark, error)
+
+		// RemoveBookmark removes a bookmark from a channel
+		RemoveBookmark(ctx context.Context, channel, bookmarkID string) error
+
+		// ListBookmarks lists bookmarks of a channel
+		ListBookmarks(ctx context.Context, channel string) ([]Bookmark, error)
+	}
+
+	// Files provides api to work with files
+	Files interface {
+		// UploadFile uploads files and optionally shares them to a channel. When some of multiple files fail to
+		// upload, the uploaded ones are returned unshared along with *PartialUploadError
+		UploadFile(ctx context.Context, r io.Reader, opts ...UploadOption) ([]File, error)
+
+		// GetFileInfo gets information about a file
+		GetFileInfo(ctx context.Context, fileID string) (File, error)
+
+		// ListFiles lists files visible to the authed user
+		ListFiles(ctx context.Context, opts ...ListOption) (FilesList, error)
+
+		// DeleteFile deletes a file
+		DeleteFile(ctx context.Context, fileID string) error
+
+		// ShareFilePublicURL enables a file for public sharing
+		ShareFilePublicURL(ctx context.Context, fileID string) (File, error)
+
+		// RevokeFilePublicURL revokes public sharing of a file
+		RevokeFilePublicURL(ctx context.Context, fileID string) (File, error)
+
+		// DownloadFile streams content of a file to the writer
+		DownloadFile(ctx context.Context, file File, w io.Writer) error
+	}
+
+	// Views provides api to work with modals and App Home
+	Views interface {
+		// OpenView opens a modal in response to an interaction with the trigger id
+		OpenView(ctx context.Context, triggerID string, view View) (View, error)
+
+		// PushView pushes a modal to the stack of the open modal in response to an interaction with the trigger id
+		PushView(ctx context.Context, triggerID string, view View) (View, error)
+
+		// UpdateView updates a modal by its id, or by external id of the view when the id is empty. A non-empty hash
+		// protects from race conditions, ErrHashConflict is returned when the view was changed since the hash
+		UpdateView(ctx context.Context, viewID, hash string, view View) (View, error)
+
+		// PublishHomeView publishes App Home view of the user. Hash works the same way as in UpdateView
+		PublishHomeView(ctx context.Context, userID, hash string, view View) (View, error)
+	}
+
+	// Auth provides api to work with the token
+	Auth interface {
+		// AuthTest checks authentication and tells who the token belongs to
+		AuthTest(ctx context.Context) (AuthInfo, error)
+
+		// TokenInfo returns information about the token including its type and granted scopes. Successful results and
+		// auth errors like invalid_auth are cached, with WithTokenVerification option they are cached on client
+		// construction. A cached auth error is dropped after any successful call of the client
+		TokenInfo(ctx context.Context) (TokenInfo, error)
+
+		// ListAuthTeams lists workspaces the org-level token is granted access to
+		ListAuthTeams(ctx context.Context, opts ...ListOption) (TeamsList, error)
+	}
+
+	// Teams provides api to work with workspaces
+	Teams interface {
+		// GetTeamInfo gets information about a workspace, the one of the token when the team id is empty
+		GetTeamInfo(ctx context.Context, teamID string) (Team, error)
+	}
+
+	// StatusCodeError slack respond with unexpected status code
+	StatusCodeError struct {
+		// StatusCode http status code of the response
+		StatusCode int
 	}
 
 	client struct {
-		token      string
-		baseUrl    string
-		httpClient HTTPClient
+		tokenSource TokenSource
+		baseUrl     string
+		httpClient  HTTPClient
+		rateLimiter *RateLimiter
+		errorHook   func(ctx context.Context, code string)
+		middlewares []Middleware
+		roundTrip   RoundTrip
+		teamID      string
+
+		// persistErrorHook is used only by clients of rotating token sources
+		persistErrorHook func(ctx context.Context, err error)
+
+		verifyCtx context.Context
+		tokenInfo *tokenInfoCache
 	}
 )
 
-// NewClient is client constructor
+// NewClient is client constructor. The token is ignored when WithTokenSource option is used
 func NewClient(token string, opts ...ClientOption) Client {
+	return newClient(token, opts...)
+}
+
+// NewVerifiedClient is client constructor verifying the token with auth.test method, the verification error is
+// returned instead of the client. Result of the verification is cached, see Client.TokenInfo
+func NewVerifiedClient(ctx context.Context, token string, opts ...ClientOption) (Client, error) {
+	c := newClient(token, opts...)
+
+	if _, err := getTokenInfo(ctx, c); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func newClient(token string, opts ...ClientOption) *client {
 	c := &client{
-		token:      token,
-		baseUrl:    defaultBaseUrl,
-		httpClient: &http.Client{},
+		tokenSource: StaticToken(token),
+		baseUrl:     defaultBaseUrl,
+		httpClient:  &http.Client{},
+		rateLimiter: NewRateLimiter(),
+		tokenInfo:   &tokenInfoCache{},
 	}
 
 	for _, opt := range opts {
 		opt.apply(c)
 	}
 
+	c.roundTrip = chain(c.middlewares, c.do)
+
+	if c.verifyCtx != nil {
+		verifyToken(c.verifyCtx, c)
+		c.verifyCtx = nil
+	}
+
 	return c
 }
 
@@ -66,6 +247,136 @@ func (c *client) PostMessage(ctx context.Context, text, channel string, opts ...
 	return postMessage(ctx, c, text, channel, opts...)
 }
 
+// AddReaction implementation
+func (c *client) AddReaction(ctx context.Context, name string, msg MessageRef) error {
+	return addReaction(ctx, c, name, msg)
+}
+
+// RemoveReaction implementation
+func (c *client) RemoveReaction(ctx context.Context, name string, item ItemRef) error {
+	return removeReaction(ctx, c, name, item)
+}
+
+// GetReactions implementation
+func (c *client) GetReactions(ctx context.Context, item ItemRef) (ReactedItem, error) {
+	return getReactions(ctx, c, item)
+}
+
+// ListReactions implementation
+func (c *client) ListReactions(ctx context.Context, opts ...ListOption) (ReactedItems, error) {
+	return listReactions(ctx, c, opts...)
+}
+
+// AddPin implementation
+func (c *client) AddPin(ctx context.Context, msg MessageRef) error {
+	return addPin(ctx, c, msg)
+}
+
+// RemovePin implementation
+func (c *client) RemovePin(ctx context.Context, msg MessageRef) error {
+	return removePin(ctx, c, msg)
+}
+
+// ListPins implementation
+func (c *client) ListPins(ctx context.Context, channel string) ([]Pin, error) {
+	return listPins(ctx, c, channel)
+}
+
+// AddBookmark implementation
+func (c *client) AddBookmark(ctx context.Context, channel, title, link string, opts ...BookmarkOption) (Bookmark, error) {
+	return addBookmark(ctx, c, channel, title, link, opts...)
+}
+
+// EditBookmark implementation
+func (c *client) EditBookmark(ctx context.Context, channel, bookmarkID string, opts ...BookmarkOption) (Bookmark, error) {
+	return editBookmark(ctx, c, channel, bookmarkID, opts...)
+}
+
+// RemoveBookmark implementation
+func (c *client) RemoveBookmark(ctx context.Context, channel, bookmarkID string) error {
+	return removeBookmark(ctx, c, channel, bookmarkID)
+}
+
+// ListBookmarks implementation
+func (c *client) ListBookmarks(ctx context.Context, channel string) ([]Bookmark, error) {
+	return listBookmarks(ctx, c, channel)
+}
+
+// UploadFile implementation
+func (c *client) UploadFile(ctx context.Context, r io.Reader, opts ...UploadOption) ([]File, error) {
+	return uploadFile(ctx, c, r, opts...)
+}
+
+// GetFileInfo implementation
+func (c *client) GetFileInfo(ctx context.Context, fileID string) (File, error) {
+	return getFileInfo(ctx, c, fileID)
+}
+
+// ListFiles implementation
+func (c *client) ListFiles(ctx context.Context, opts ...ListOption) (FilesList, error) {
+	return listFiles(ctx, c, opts...)
+}
+
+// DeleteFile implementation
+func (c *client) DeleteFile(ctx context.Context, fileID string) error {
+	return deleteFile(ctx, c, fileID)
+}
+
+// ShareFilePublicURL implementation
+func (c *client) ShareFilePublicURL(ctx context.Context, fileID string) (File, error) {
+	return shareFilePublicURL(ctx, c, fileID)
+}
+
+// RevokeFilePublicURL implementation
+func (c *client) RevokeFilePublicURL(ctx context.Context, fileID string) (File, error) {
+	return revokeFilePublicURL(ctx, c, fileID)
+}
+
+// DownloadFile implementation
+func (c *client) DownloadFile(ctx context.Context, file File, w io.Writer) error {
+	return downloadFile(ctx, c, file, w)
+}
+
+// OpenView implementation
+func (c *client) OpenView(ctx context.Context, triggerID string, view View) (View, error) {
+	return openView(ctx, c, triggerID, view)
+}
+
+// PushView implementation
+func (c *client) PushView(ctx context.Context, triggerID string, view View) (View, error) {
+	return pushView(ctx, c, triggerID, view)
+}
+
+// UpdateView implementation
+func (c *client) UpdateView(ctx context.Context, viewID, hash string, view View) (View, error) {
+	return updateView(ctx, c, viewID, hash, view)
+}
+
+// PublishHomeView implementation
+func (c *client) PublishHomeView(ctx context.Context, userID, hash string, view View) (View, error) {
+	return publishHomeView(ctx, c, userID, hash, view)
+}
+
+// AuthTest implementation
+func (c *client) AuthTest(ctx context.Context) (AuthInfo, error) {
+	return authTest(ctx, c)
+}
+
+// TokenInfo implementation
+func (c *client) TokenInfo(ctx context.Context) (TokenInfo, error) {
+	return getTokenInfo(ctx, c)
+}
+
+// ListAuthTeams implementation
+func (c *client) ListAuthTeams(ctx context.Context, opts ...ListOption) (TeamsList, error) {
+	return listAuthTeams(ctx, c, opts...)
+}
+
+// GetTeamInfo implementation
+func (c *client) GetTeamInfo(ctx context.Context, teamID string) (Team, error) {
+	return getTeamInfo(ctx, c, teamID)
+}
+
 func (c *client) get(ctx context.Context, path string) ([]byte, error) {
 	return c.SendRequest(ctx, http.MethodGet, path, nil)
 }
@@ -76,33 +387,124 @@ func (c *client) post(ctx context.Context, path string, data []byte) ([]byte, er
 
 // SendRequest implementation
 func (c *client) SendRequest(ctx context.Context, method string, path string, data []byte) ([]byte, error) {
-	if len(c.token) == 0 {
-		return nil, errors.New("token is required")
+	body, _, err := c.sendRequest(ctx, method, path, contentTypeJSON, data)
+
+	return body, err
+}
+
+// SendFormRequest implementation
+func (c *client) SendFormRequest(ctx context.Context, path string, data []byte) ([]byte, error) {
+	body, _, err := c.sendRequest(ctx, http.MethodPost, path, contentTypeForm, data)
+
+	return body, err
+}
+
+func (c *client) sendRequest(
+	ctx context.Context,
+	method string,
+	path string,
+	contentType string,
+	data []byte,
+) ([]byte, http.Header, error) {
+	token, err := c.tokenSource.Token(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't get token: %w", err)
+	}
+
+	if path, data, err = scopeToTeam(c.teamID, contentType, method, path, data); err != nil {
+		return nil, nil, err
+	}
+
+	body, header, err := c.doRequest(ctx, token, method, path, contentType, data, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if refresher, ok := c.tokenSource.(RefreshableTokenSource); ok && errorCode(body) == tokenExpiredError {
+		if token, err = refresher.Refresh(ctx, token); err != nil {
+			return nil, nil, fmt.Errorf("can't refresh expired token: %w", err)
+		}
+
+		if body, header, err = c.doRequest(ctx, token, method, path, contentType, data, 2); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	code := errorCode(body)
+	if c.errorHook != nil && len(code) > 0 {
+		c.errorHook(ctx, code)
+	}
+
+	// the token is accepted, a cached verification error is outdated, e.g. after a reinstall or a reactivation
+	if len(code) == 0 {
+		c.tokenInfo.clearError()
+	}
+
+	return body, header, nil
+}
+
+func (c *client) doRequest(
+	ctx context.Context,
+	token string,
+	method string,
+	path string,
+	contentType string,
+	data []byte,
+	attempt int,
+) ([]byte, http.Header, error) {
+	if len(token) == 0 {
+		return nil, nil, errors.New("token is required")
 	}
 
 	req, err := http.NewRequest(method, c.baseUrl+"/"+path, bytes.NewReader(data))
 	if nil != err {
-		return nil, fmt.Errorf("can't create http request: %w", err)
+		return nil, nil, fmt.Errorf("can't create http request: %w", err)
 	}
 
 	req = req.WithContext(ctx)
 
-	req.Header.Add("Authorization", "Bearer "+c.token)
-	req.Header.Add("Content-Type", "application/json; charset=utf-8")
+	req.Header.Add("Authorization", "Bearer "+token)
+	req.Header.Add("Content-Type", contentType)
+
+	apiReq := newRequest(req, path, token, data, attempt)
+
+	waitStart := time.Now()
+	if err = c.rateLimiter.Wait(ctx); err != nil {
+		return nil, nil, fmt.Errorf("can't wait for rate limit: %w", err)
+	}
+
+	apiReq.RateLimitWait = time.Since(waitStart)
 
 	var resp *http.Response
-	if resp, err = c.httpClient.Do(req); nil != err {
-		return nil, fmt.Errorf("can't send http request: %w", err)
+	if resp, err = c.roundTrip(ctx, apiReq); nil != err {
+		return nil, nil, fmt.Errorf("can't send http request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if http.StatusTooManyRequests == resp.StatusCode {
+		delay := retryAfter(resp.Header)
+		c.rateLimiter.block(delay)
+
+		return nil, nil, &RateLimitedError{RetryAfter: delay}
 	}
 
 	if http.StatusOK != resp.StatusCode {
-		return nil, fmt.Errorf("slack respond with %d status code", resp.StatusCode)
+		return nil, nil, &StatusCodeError{StatusCode: resp.StatusCode}
 	}
 
 	var body []byte
 	if body, err = ioutil.ReadAll(resp.Body); nil != err {
-		return nil, fmt.Errorf("can't read response body: %w", err)
+		return nil, nil, fmt.Errorf("can't read response body: %w", err)
 	}
 
-	return body, nil
+	return body, resp.Header, nil
+}
+
+// Error implementation
+func (e *StatusCodeError) Error() string {
+	return fmt.Sprintf("slack respond with %d status code", e.StatusCode)
+}
+
+func (c *client) do(_ context.Context, req *Request) (*http.Response, error) {
+	return c.httpClient.Do(req.HTTPRequest)
 }
@@ -1,6 +1,8 @@
 // Package slack - client options
 package slack
 
+import "context"
+
 type (
 	// ClientOption to use optional parameters in slack client
 	ClientOption interface {
@@ -14,6 +16,38 @@ type (
 	withBaseUrl struct {
 		baseUrl string
 	}
+
+	withTokenSource struct {
+		tokenSource TokenSource
+	}
+
+	withRateLimiter struct {
+		rateLimiter *RateLimiter
+	}
+
+	withErrorHook struct {
+		hook func(ctx context.Context, code string)
+	}
+
+	withMiddleware struct {
+		middlewares []Middleware
+	}
+
+	withTeam struct {
+		teamID string
+	}
+
+	withLogger struct {
+		logging *logging
+	}
+
+	withTokenVerification struct {
+		ctx context.Context
+	}
+
+	withPersistErrorHook struct {
+		hook func(ctx context.Context, err error)
+	}
 )
 
 // WithHttpClient replaces default http client
@@ -33,3 +67,91 @@ func WithBaseUrl(baseUrl string) ClientOption {
 func (opt withBaseUrl) apply(c *client) {
 	c.baseUrl = opt.baseUrl
 }
+
+// WithTokenVerification verifies the token on client construction with auth.test method. Result of the verification
+// is available with Client.TokenInfo, failures other than auth errors like invalid_auth are retried by it. Use
+// NewVerifiedClient to get the failure on construction
+func WithTokenVerification(ctx context.Context) ClientOption {
+	return &withTokenVerification{ctx: ctx}
+}
+
+func (opt *withTokenVerification) apply(c *client) {
+	c.verifyCtx = opt.ctx
+}
+
+// WithTokenSource replaces the static token passed to the client constructor, e.g. with a rotating token
+func WithTokenSource(tokenSource TokenSource) ClientOption {
+	return &withTokenSource{tokenSource: tokenSource}
+}
+
+func (opt *withTokenSource) apply(c *client) {
+	c.tokenSource = opt.tokenSource
+}
+
+// WithRateLimiter replaces rate limiter of the client, e.g. to share it among clients using the same token
+func WithRateLimiter(rateLimiter *RateLimiter) ClientOption {
+	return &withRateLimiter{rateLimiter: rateLimiter}
+}
+
+func (opt *withRateLimiter) apply(c *client) {
+	c.rateLimiter = opt.rateLimiter
+}
+
+// WithErrorHook sets a hook called with the error code of every slack response with ok=false
+func WithErrorHook(hook func(ctx context.Context, code string)) ClientOption {
+	return &withErrorHook{hook: hook}
+}
+
+func (opt *withErrorHook) apply(c *client) {
+	c.errorHook = opt.hook
+}
+
+// WithPersistErrorHook sets a hook of NewRotatingTokenSource called when a refreshed token can't be persisted. The
+// refreshed token is used anyway, the refresh token it replaces is already revoked by slack
+func WithPersistErrorHook(hook func(ctx context.Context, err error)) ClientOption {
+	return &withPersistErrorHook{hook: hook}
+}
+
+func (opt *withPersistErrorHook) apply(c *client) {
+	c.persistErrorHook = opt.hook
+}
+
+// WithMiddleware adds middlewares wrapping every request of the client including file transfers. Token refreshes
+// are sent by the token source, pass the option to NewRotatingTokenSource to wrap them. The first middleware is the
+// outermost
+func WithMiddleware(middlewares ...Middleware) ClientOption {
+	return &withMiddleware{middlewares: middlewares}
+}
+
+func (opt *withMiddleware) apply(c *client) {
+	c.middlewares = append(c.middlewares, opt.middlewares...)
+}
+
+// WithLogger logs every request of the client with its method, duration, status and slack error
+func WithLogger(logger Logger, opts ...LogOption) ClientOption {
+	l := &logging{
+		logger:       logger,
+		successLevel: LogLevelDebug,
+		failureLevel: LogLevelWarn,
+	}
+
+	for _, opt := range opts {
+		opt.apply(l)
+	}
+
+	return &withLogger{logging: l}
+}
+
+func (opt *withLogger) apply(c *client) {
+	c.middlewares = append(c.middlewares, opt.logging.middleware)
+}
+
+// WithTeam scopes the client to a workspace of Enterprise Grid organization, team_id is added to every request
+// which doesn't have it. Use ForTeam to get a scoped copy of an existing client
+func WithTeam(teamID string) ClientOption {
+	return &withTeam{teamID: teamID}
+}
+
+func (opt *withTeam) apply(c *client) {
+	c.teamID = opt.teamID
+}
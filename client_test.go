@@ -107,3 +107,32 @@ func TestClient_SendRequest(t *testing.T) {
 		assert.Equal(t, err.Error(), fmt.Sprintf("slack respond with %d status code", expStatus))
 	})
 }
+
+func TestWithErrorHook(t *testing.T) {
+	httpClient := new(slack.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":false,"error":"account_inactive"}`))),
+		StatusCode: http.StatusOK,
+	}, nil).Once()
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":true}`))),
+		StatusCode: http.StatusOK,
+	}, nil).Once()
+
+	var codes []string
+	client := slack.NewClient(
+		"test_token",
+		slack.WithHttpClient(httpClient),
+		slack.WithErrorHook(func(ctx context.Context, code string) {
+			codes = append(codes, code)
+		}),
+	)
+
+	_, err := client.SendRequest(context.Background(), http.MethodGet, "/test/path", nil)
+	assert.NoError(t, err)
+
+	_, err = client.SendRequest(context.Background(), http.MethodGet, "/test/path", nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"account_inactive"}, codes)
+}
@@ -0,0 +1,119 @@
+// Package commands - slash commands
+package commands
+
+import (
+	"net/url"
+
+	"github.com/kryabinin/go-slack"
+)
+
+const (
+	// ResponseEphemeral response visible only to the user invoked the command
+	ResponseEphemeral = "ephemeral"
+
+	// ResponseInChannel response visible to all members of the channel
+	ResponseInChannel = "in_channel"
+)
+
+type (
+	// SlashCommand invocation of a slash command
+	SlashCommand struct {
+		// Command the command that was typed in, e.g. /weather
+		Command string `json:"command"`
+
+		// Text part of the command after the command name
+		Text string `json:"text"`
+
+		// UserID user who invoked the command
+		UserID string `json:"user_id"`
+
+		// UserName deprecated name of the user
+		UserName string `json:"user_name"`
+
+		// ChannelID channel the command was invoked in
+		ChannelID string `json:"channel_id"`
+
+		// ChannelName name of the channel
+		ChannelName string `json:"channel_name"`
+
+		// ResponseURL url to send delayed responses to, valid for 30 minutes
+		ResponseURL string `json:"response_url"`
+
+		// TriggerID short-lived id to open a modal
+		TriggerID string `json:"trigger_id"`
+
+		// TeamID workspace the command was invoked in
+		TeamID string `json:"team_id"`
+
+		// TeamDomain domain of the workspace
+		TeamDomain string `json:"team_domain"`
+
+		// EnterpriseID Enterprise Grid organization the command was invoked in
+		EnterpriseID string `json:"enterprise_id"`
+
+		// EnterpriseName name of the Enterprise Grid organization
+		EnterpriseName string `json:"enterprise_name"`
+
+		// APIAppID app the command is intended for
+		APIAppID string `json:"api_app_id"`
+
+		// IsEnterpriseInstall true if the app is installed to the whole organization
+		IsEnterpriseInstall bool `json:"is_enterprise_install"`
+	}
+
+	// Response reply to a slash command
+	Response struct {
+		// Text text of the reply, used as a fallback in notifications if blocks are set
+		Text string `json:"text,omitempty"`
+
+		// Attachments attachments of the reply
+		Attachments []slack.Attachment `json:"attachments,omitempty"`
+
+		// Blocks layout blocks of the reply
+		Blocks slack.Blocks `json:"blocks,omitempty"`
+
+		// ResponseType ResponseEphemeral by default or ResponseInChannel
+		ResponseType string `json:"response_type,omitempty"`
+
+		// ReplaceOriginal replace the previous reply to the command, used only for delayed responses
+		ReplaceOriginal bool `json:"replace_original,omitempty"`
+	}
+)
+
+// ParseSlashCommand parses form payload of a slash command
+func ParseSlashCommand(form url.Values) SlashCommand {
+	return SlashCommand{
+		Command:             form.Get("command"),
+		Text:                form.Get("text"),
+		UserID:              form.Get("user_id"),
+		UserName:            form.Get("user_name"),
+		ChannelID:           form.Get("channel_id"),
+		ChannelName:         form.Get("channel_name"),
+		ResponseURL:         form.Get("response_url"),
+		TriggerID:           form.Get("trigger_id"),
+		TeamID:              form.Get("team_id"),
+		TeamDomain:          form.Get("team_domain"),
+		EnterpriseID:        form.Get("enterprise_id"),
+		EnterpriseName:      form.Get("enterprise_name"),
+		APIAppID:            form.Get("api_app_id"),
+		IsEnterpriseInstall: form.Get("is_enterprise_install") == "true",
+	}
+}
+
+// Ephemeral makes a response visible only to the user invoked the command
+func Ephemeral(text string, attachments ...slack.Attachment) *Response {
+	return &Response{
+		Text:         text,
+		Attachments:  attachments,
+		ResponseType: ResponseEphemeral,
+	}
+}
+
+// InChannel makes a response visible to all members of the channel
+func InChannel(text string, attachments ...slack.Attachment) *Response {
+	return &Response{
+		Text:         text,
+		Attachments:  attachments,
+		ResponseType: ResponseInChannel,
+	}
+}
@@ -0,0 +1,146 @@
+// Package commands - slash commands handler
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/kryabinin/go-slack"
+	"github.com/kryabinin/go-slack/signature"
+)
+
+type (
+	// HandlerFunc handles a command. The returned response is sent as the reply, nil response acknowledges the
+	// command with an empty reply
+	HandlerFunc func(ctx context.Context, cmd SlashCommand) (*Response, error)
+
+	// Handler http handler of the slash commands request url. Routes commands by their names
+	Handler struct {
+		verifier *signature.Verifier
+		maxAge   time.Duration
+		ctx      context.Context
+		webhook  slack.WebhookClient
+		onError  func(ctx context.Context, cmd SlashCommand, err error)
+
+		mu       sync.RWMutex
+		handlers map[string]HandlerFunc
+	}
+)
+
+// NewHandler is slash commands handler constructor
+func NewHandler(signingSecret string, opts ...Option) *Handler {
+	h := &Handler{
+		ctx:      context.Background(),
+		webhook:  slack.NewWebhookClient(),
+		onError:  func(context.Context, SlashCommand, error) {},
+		handlers: make(map[string]HandlerFunc),
+	}
+
+	for _, opt := range opts {
+		opt.apply(h)
+	}
+
+	h.verifier = signature.NewVerifier(signingSecret, h.maxAge)
+
+	return h
+}
+
+// On registers handler of the command replying synchronously, within 3 seconds
+func (h *Handler) On(command string, handler HandlerFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.handlers[command] = handler
+}
+
+// Defer registers handler of the command doing long work. The command is acknowledged with the ack response right
+// away, nil ack means an empty reply. The handler is called asynchronously and its response is sent to the
+// response url of the command
+func (h *Handler) Defer(command string, ack *Response, handler HandlerFunc) {
+	h.On(command, func(ctx context.Context, cmd SlashCommand) (*Response, error) {
+		go h.respondLater(cmd, handler)
+
+		return ack, nil
+	})
+}
+
+// ServeHTTP implementation
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := h.verifier.VerifyRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		http.Error(w, "can't parse request", http.StatusBadRequest)
+		return
+	}
+
+	cmd := ParseSlashCommand(form)
+
+	h.mu.RLock()
+	handler, ok := h.handlers[cmd.Command]
+	h.mu.RUnlock()
+
+	if !ok {
+		http.Error(w, "unknown command "+cmd.Command, http.StatusNotFound)
+		return
+	}
+
+	resp, err := call(r.Context(), handler, cmd)
+	if err != nil {
+		h.onError(r.Context(), cmd, err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	if resp == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (h *Handler) respondLater(cmd SlashCommand, handler HandlerFunc) {
+	resp, err := call(h.ctx, handler, cmd)
+	if err == nil && resp != nil {
+		err = h.respond(h.ctx, cmd.ResponseURL, resp)
+	}
+
+	if err != nil {
+		h.onError(h.ctx, cmd, err)
+	}
+}
+
+func (h *Handler) respond(ctx context.Context, responseURL string, resp *Response) error {
+	return h.webhook.Send(ctx, responseURL, slack.WebhookMessage{
+		Message:         slack.Message{Text: resp.Text, Attachments: resp.Attachments},
+		Blocks:          resp.Blocks,
+		ResponseType:    resp.ResponseType,
+		ReplaceOriginal: resp.ReplaceOriginal,
+	})
+}
+
+func call(ctx context.Context, handler HandlerFunc, cmd SlashCommand) (resp *Response, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			resp, err = nil, fmt.Errorf("command handler panic: %v", r)
+		}
+	}()
+
+	return handler(ctx, cmd)
+}
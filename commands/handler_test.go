@@ -0,0 +1,176 @@
+package commands_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-slack"
+	"github.com/kryabinin/go-slack/commands"
+	"github.com/kryabinin/go-slack/signature"
+)
+
+const signingSecret = "test_secret"
+
+func signedRequest(form url.Values) *http.Request {
+	now := time.Now().Unix()
+	body := form.Encode()
+
+	req := httptest.NewRequest(http.MethodPost, "/slack/commands", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set(signature.HeaderTimestamp, strconv.FormatInt(now, 10))
+	req.Header.Set(signature.HeaderSignature, signature.Sign(signingSecret, now, []byte(body)))
+
+	return req
+}
+
+func commandForm(command, text, responseURL string) url.Values {
+	return url.Values{
+		"command":               {command},
+		"text":                  {text},
+		"user_id":               {"U1"},
+		"channel_id":            {"C1"},
+		"team_id":               {"T1"},
+		"enterprise_id":         {"E1"},
+		"trigger_id":            {"13345224609.738474920.8088930838d88f008e0"},
+		"response_url":          {responseURL},
+		"is_enterprise_install": {"true"},
+	}
+}
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	t.Run("synchronous reply", func(t *testing.T) {
+		h := commands.NewHandler(signingSecret)
+		h.On("/echo", func(ctx context.Context, cmd commands.SlashCommand) (*commands.Response, error) {
+			assert.Equal(t, commands.SlashCommand{
+				Command:             "/echo",
+				Text:                "hello",
+				UserID:              "U1",
+				ChannelID:           "C1",
+				TeamID:              "T1",
+				EnterpriseID:        "E1",
+				TriggerID:           "13345224609.738474920.8088930838d88f008e0",
+				ResponseURL:         "https://hooks.slack.com/commands/1",
+				IsEnterpriseInstall: true,
+			}, cmd)
+
+			return commands.InChannel(cmd.Text, slack.Attachment{Text: "attached"}), nil
+		})
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, signedRequest(commandForm("/echo", "hello", "https://hooks.slack.com/commands/1")))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"response_type":"in_channel","text":"hello",`+
+			`"attachments":[{"text":"attached"}]}`, rec.Body.String())
+	})
+
+	t.Run("empty reply", func(t *testing.T) {
+		h := commands.NewHandler(signingSecret)
+		h.On("/noop", func(ctx context.Context, cmd commands.SlashCommand) (*commands.Response, error) {
+			return nil, nil
+		})
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, signedRequest(commandForm("/noop", "", "")))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Body.String())
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		h := commands.NewHandler("other_secret")
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, signedRequest(commandForm("/echo", "", "")))
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("unknown command", func(t *testing.T) {
+		h := commands.NewHandler(signingSecret)
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, signedRequest(commandForm("/unknown", "", "")))
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("handler error", func(t *testing.T) {
+		expErr := errors.New("test error")
+
+		var handled error
+		h := commands.NewHandler(signingSecret, commands.WithErrorHandler(
+			func(ctx context.Context, cmd commands.SlashCommand, err error) {
+				handled = err
+			},
+		))
+		h.On("/fail", func(ctx context.Context, cmd commands.SlashCommand) (*commands.Response, error) {
+			return nil, expErr
+		})
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, signedRequest(commandForm("/fail", "", "")))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Equal(t, expErr, handled)
+	})
+
+	t.Run("deferred reply", func(t *testing.T) {
+		received := make(chan string, 1)
+
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, "https://hooks.slack.com/commands/1", req.URL.String())
+
+			body, err := ioutil.ReadAll(req.Body)
+			assert.NoError(t, err)
+
+			received <- string(body)
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(strings.NewReader("ok")),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		h := commands.NewHandler(signingSecret, commands.WithHttpClient(httpClient))
+		h.Defer("/report", commands.Ephemeral("working on it"),
+			func(ctx context.Context, cmd commands.SlashCommand) (*commands.Response, error) {
+				resp := commands.Ephemeral("report for " + cmd.Text)
+				resp.Blocks = slack.Blocks{&slack.SectionBlock{
+					Type: "section",
+					Text: &slack.TextObject{Type: "mrkdwn", Text: "*done*"},
+				}}
+				resp.ReplaceOriginal = true
+
+				return resp, nil
+			},
+		)
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, signedRequest(commandForm("/report", "today", "https://hooks.slack.com/commands/1")))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"response_type":"ephemeral","text":"working on it"}`, rec.Body.String())
+
+		select {
+		case body := <-received:
+			assert.JSONEq(t, `{"response_type":"ephemeral","text":"report for today","replace_original":true,`+
+				`"blocks":[{"type":"section","text":{"type":"mrkdwn","text":"*done*"}}]}`, body)
+		case <-time.After(time.Second):
+			t.Fatal("deferred response is not sent")
+		}
+	})
+}
@@ -0,0 +1,68 @@
+// Package commands - handler options
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/kryabinin/go-slack"
+)
+
+type (
+	// Option to use optional parameters in slash commands handler
+	Option interface {
+		apply(h *Handler)
+	}
+
+	withMaxAge struct {
+		maxAge time.Duration
+	}
+
+	withContext struct {
+		ctx context.Context
+	}
+
+	withHttpClient struct {
+		httpClient slack.HTTPClient
+	}
+
+	withErrorHandler struct {
+		handler func(ctx context.Context, cmd SlashCommand, err error)
+	}
+)
+
+// WithMaxAge sets maximum age of requests, 5 minutes by default
+func WithMaxAge(maxAge time.Duration) Option {
+	return &withMaxAge{maxAge: maxAge}
+}
+
+func (opt *withMaxAge) apply(h *Handler) {
+	h.maxAge = opt.maxAge
+}
+
+// WithContext sets context passed to deferred command handlers, background context by default
+func WithContext(ctx context.Context) Option {
+	return &withContext{ctx: ctx}
+}
+
+func (opt *withContext) apply(h *Handler) {
+	h.ctx = opt.ctx
+}
+
+// WithHttpClient sets http client sending deferred responses
+func WithHttpClient(httpClient slack.HTTPClient) Option {
+	return &withHttpClient{httpClient: httpClient}
+}
+
+func (opt *withHttpClient) apply(h *Handler) {
+	h.webhook = slack.NewWebhookClient(slack.WithWebhookHttpClient(opt.httpClient))
+}
+
+// WithErrorHandler sets handler of errors returned by command handlers
+func WithErrorHandler(handler func(ctx context.Context, cmd SlashCommand, err error)) Option {
+	return &withErrorHandler{handler: handler}
+}
+
+func (opt *withErrorHandler) apply(h *Handler) {
+	h.onError = opt.handler
+}
@@ -0,0 +1,126 @@
+// Package events - Events API
+package events
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+const (
+	// TypeURLVerification type of the request verifying the events url
+	TypeURLVerification = "url_verification"
+
+	// TypeEventCallback type of the request delivering an event
+	TypeEventCallback = "event_callback"
+
+	// TypeAppRateLimited type of the request notifying the app exceeded the events rate limit
+	TypeAppRateLimited = "app_rate_limited"
+)
+
+type (
+	// Callback outer event envelope
+	Callback struct {
+		// Token deprecated verification token, use signing secret instead
+		Token string `json:"token"`
+
+		// TeamID workspace the event occurred in
+		TeamID string `json:"team_id"`
+
+		// EnterpriseID Enterprise Grid organization the event occurred in
+		EnterpriseID string `json:"enterprise_id"`
+
+		// APIAppID app the event is intended for
+		APIAppID string `json:"api_app_id"`
+
+		// Type type of the callback, always event_callback
+		Type string `json:"type"`
+
+		// EventID unique identifier of the event, the same for retries
+		EventID string `json:"event_id"`
+
+		// EventTime a unix timestamp when the event was dispatched
+		EventTime int64 `json:"event_time"`
+
+		// EventContext identifier of the event context
+		EventContext string `json:"event_context"`
+
+		// IsExtSharedChannel true if the event occurred in an externally shared channel
+		IsExtSharedChannel bool `json:"is_ext_shared_channel"`
+
+		// Authorizations installations the event is visible to
+		Authorizations []Authorization `json:"authorizations"`
+
+		// RawEvent inner event json
+		RawEvent json.RawMessage `json:"event"`
+
+		// Event decoded inner event, a pointer to the registered type, e.g. *AppMentionEvent, or *RawEvent for unknown
+		// events
+		Event interface{} `json:"-"`
+
+		// EventType type of the inner event
+		EventType string `json:"-"`
+
+		// RetryNum number of the delivery retry, zero for the first attempt
+		RetryNum int `json:"-"`
+
+		// RetryReason reason of the delivery retry, e.g. http_timeout
+		RetryReason string `json:"-"`
+	}
+
+	// Authorization installation the event is visible to
+	Authorization struct {
+		// EnterpriseID Enterprise Grid organization of the installation
+		EnterpriseID string `json:"enterprise_id"`
+
+		// TeamID workspace of the installation
+		TeamID string `json:"team_id"`
+
+		// UserID user or bot of the installation
+		UserID string `json:"user_id"`
+
+		// IsBot true for bot installations
+		IsBot bool `json:"is_bot"`
+
+		// IsEnterpriseInstall true for organization wide installations
+		IsEnterpriseInstall bool `json:"is_enterprise_install"`
+	}
+
+	// RawEvent inner event without a registered type
+	RawEvent struct {
+		// Type event type
+		Type string `json:"type"`
+
+		// Subtype event subtype
+		Subtype string `json:"subtype"`
+
+		// Data event json
+		Data json.RawMessage `json:"-"`
+	}
+
+	urlVerification struct {
+		Challenge string `json:"challenge"`
+	}
+)
+
+// ParseCallback parses event callback json and decodes its inner event
+func ParseCallback(data []byte) (Callback, error) {
+	var callback Callback
+	if err := json.Unmarshal(data, &callback); err != nil {
+		return Callback{}, errors.New("can't unmarshal event callback")
+	}
+
+	var header RawEvent
+	if err := json.Unmarshal(callback.RawEvent, &header); err != nil {
+		return Callback{}, errors.New("can't unmarshal event")
+	}
+
+	event, err := DecodeEvent(callback.RawEvent)
+	if err != nil {
+		return Callback{}, errors.New("can't unmarshal event")
+	}
+
+	callback.Event = event
+	callback.EventType = header.Type
+
+	return callback, nil
+}
@@ -0,0 +1,101 @@
+// Package events - events handler
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/kryabinin/go-slack/signature"
+)
+
+type (
+	// Handler http handler of the Events API request url. Requests are acknowledged right after verification, event
+	// handlers are called asynchronously
+	Handler struct {
+		*Router
+
+		verifier *signature.Verifier
+		maxAge   time.Duration
+		ctx      context.Context
+		onError  func(ctx context.Context, callback Callback, err error)
+	}
+)
+
+// NewHandler is events handler constructor
+func NewHandler(signingSecret string, opts ...Option) *Handler {
+	h := &Handler{
+		Router:  NewRouter(),
+		ctx:     context.Background(),
+		onError: func(context.Context, Callback, error) {},
+	}
+
+	for _, opt := range opts {
+		opt.apply(h)
+	}
+
+	h.verifier = signature.NewVerifier(signingSecret, h.maxAge)
+
+	return h
+}
+
+// ServeHTTP implementation
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := h.verifier.VerifyRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	var envelope struct {
+		Type string `json:"type"`
+		urlVerification
+	}
+
+	if err = json.Unmarshal(body, &envelope); err != nil {
+		http.Error(w, "can't unmarshal request", http.StatusBadRequest)
+		return
+	}
+
+	switch envelope.Type {
+	case TypeURLVerification:
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(envelope.urlVerification)
+	case TypeEventCallback:
+		callback, err := parseCallback(r, body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		go h.dispatch(callback)
+	default:
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func (h *Handler) dispatch(callback Callback) {
+	if err := h.Dispatch(h.ctx, callback); err != nil {
+		h.onError(h.ctx, callback, err)
+	}
+}
+
+func parseCallback(r *http.Request, body []byte) (Callback, error) {
+	callback, err := ParseCallback(body)
+	if err != nil {
+		return Callback{}, err
+	}
+
+	callback.RetryNum, _ = strconv.Atoi(r.Header.Get("X-Slack-Retry-Num"))
+	callback.RetryReason = r.Header.Get("X-Slack-Retry-Reason")
+
+	return callback, nil
+}
@@ -0,0 +1,151 @@
+package events_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kryabinin/go-slack/events"
+	"github.com/kryabinin/go-slack/signature"
+)
+
+const signingSecret = "test_secret"
+
+func signedRequest(body string) *http.Request {
+	now := time.Now().Unix()
+
+	req := httptest.NewRequest(http.MethodPost, "/slack/events", bytes.NewReader([]byte(body)))
+	req.Header.Set(signature.HeaderTimestamp, strconv.FormatInt(now, 10))
+	req.Header.Set(signature.HeaderSignature, signature.Sign(signingSecret, now, []byte(body)))
+
+	return req
+}
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	t.Run("url verification", func(t *testing.T) {
+		h := events.NewHandler(signingSecret)
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, signedRequest(`{"type":"url_verification","token":"t","challenge":"3eZbrw1aBm2rZgRNFdxV"}`))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"challenge":"3eZbrw1aBm2rZgRNFdxV"}`, rec.Body.String())
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		h := events.NewHandler("other_secret")
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, signedRequest(`{"type":"url_verification","challenge":"c"}`))
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		h := events.NewHandler(signingSecret)
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, signedRequest(`{`))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("event callback", func(t *testing.T) {
+		received := make(chan events.Callback, 1)
+
+		h := events.NewHandler(signingSecret)
+		h.On("app_mention", func(ctx context.Context, callback events.Callback) error {
+			received <- callback
+			return nil
+		})
+		h.OnAny(func(ctx context.Context, callback events.Callback) error {
+			t.Error("fallback handler must not be called")
+			return nil
+		})
+
+		req := signedRequest(`{"type":"event_callback","team_id":"T1","api_app_id":"A1","event_id":"Ev1",` +
+			`"event_time":1600000000,"authorizations":[{"team_id":"T1","user_id":"UB","is_bot":true}],` +
+			`"event":{"type":"app_mention","user":"U1","text":"<@UB> hi","channel":"C1"}}`)
+		req.Header.Set("X-Slack-Retry-Num", "1")
+		req.Header.Set("X-Slack-Retry-Reason", "http_timeout")
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		select {
+		case callback := <-received:
+			assert.Equal(t, "T1", callback.TeamID)
+			assert.Equal(t, "Ev1", callback.EventID)
+			assert.Equal(t, 1, callback.RetryNum)
+			assert.Equal(t, "http_timeout", callback.RetryReason)
+			assert.Equal(t, []events.Authorization{{TeamID: "T1", UserID: "UB", IsBot: true}}, callback.Authorizations)
+
+			assert.Equal(t, "app_mention", callback.EventType)
+			assert.Equal(t, &events.AppMentionEvent{
+				Type:    "app_mention",
+				User:    "U1",
+				Text:    "<@UB> hi",
+				Channel: "C1",
+			}, callback.Event)
+		case <-time.After(time.Second):
+			t.Fatal("event is not dispatched")
+		}
+	})
+
+	t.Run("fallback handler and errors", func(t *testing.T) {
+		var (
+			expErr = errors.New("test error")
+			failed = make(chan error, 1)
+		)
+
+		h := events.NewHandler(signingSecret, events.WithErrorHandler(
+			func(ctx context.Context, callback events.Callback, err error) {
+				failed <- err
+			},
+		))
+		h.OnAny(func(ctx context.Context, callback events.Callback) error {
+			return expErr
+		})
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, signedRequest(`{"type":"event_callback","event":{"type":"team_join"}}`))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		select {
+		case err := <-failed:
+			assert.Equal(t, expErr, err)
+		case <-time.After(time.Second):
+			t.Fatal("error is not reported")
+		}
+	})
+
+	t.Run("handler panic", func(t *testing.T) {
+		failed := make(chan error, 1)
+
+		h := events.NewHandler(signingSecret, events.WithErrorHandler(
+			func(ctx context.Context, callback events.Callback, err error) {
+				failed <- err
+			},
+		))
+		h.On("team_join", func(ctx context.Context, callback events.Callback) error {
+			panic("boom")
+		})
+
+		h.ServeHTTP(httptest.NewRecorder(), signedRequest(`{"type":"event_callback","event":{"type":"team_join"}}`))
+
+		select {
+		case err := <-failed:
+			assert.Contains(t, err.Error(), "boom")
+		case <-time.After(time.Second):
+			t.Fatal("panic is not reported")
+		}
+	})
+}
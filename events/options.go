@@ -0,0 +1,53 @@
+// Package events - handler options
+package events
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// Option to use optional parameters in events handler
+	Option interface {
+		apply(h *Handler)
+	}
+
+	withMaxAge struct {
+		maxAge time.Duration
+	}
+
+	withContext struct {
+		ctx context.Context
+	}
+
+	withErrorHandler struct {
+		handler func(ctx context.Context, callback Callback, err error)
+	}
+)
+
+// WithMaxAge sets maximum age of requests, 5 minutes by default
+func WithMaxAge(maxAge time.Duration) Option {
+	return &withMaxAge{maxAge: maxAge}
+}
+
+func (opt *withMaxAge) apply(h *Handler) {
+	h.maxAge = opt.maxAge
+}
+
+// WithContext sets context passed to event handlers, background context by default
+func WithContext(ctx context.Context) Option {
+	return &withContext{ctx: ctx}
+}
+
+func (opt *withContext) apply(h *Handler) {
+	h.ctx = opt.ctx
+}
+
+// WithErrorHandler sets handler of errors returned by event handlers
+func WithErrorHandler(handler func(ctx context.Context, callback Callback, err error)) Option {
+	return &withErrorHandler{handler: handler}
+}
+
+func (opt *withErrorHandler) apply(h *Handler) {
+	h.onError = opt.handler
+}
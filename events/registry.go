@@ -0,0 +1,72 @@
+// Package events - event types registry
+package events
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+var (
+	typesMu sync.RWMutex
+	types   = map[string]func() interface{}{
+		"message":                 func() interface{} { return &MessageEvent{} },
+		"message/message_changed": func() interface{} { return &MessageChangedEvent{} },
+		"message/message_deleted": func() interface{} { return &MessageDeletedEvent{} },
+		"app_mention":             func() interface{} { return &AppMentionEvent{} },
+		"reaction_added":          func() interface{} { return &ReactionAddedEvent{} },
+		"reaction_removed":        func() interface{} { return &ReactionRemovedEvent{} },
+		"member_joined_channel":   func() interface{} { return &MemberJoinedChannelEvent{} },
+		"channel_created":         func() interface{} { return &ChannelCreatedEvent{} },
+		"channel_rename":          func() interface{} { return &ChannelRenameEvent{} },
+		"channel_archive":         func() interface{} { return &ChannelArchiveEvent{} },
+		"user_change":             func() interface{} { return &UserChangeEvent{} },
+		"team_join":               func() interface{} { return &TeamJoinEvent{} },
+		"file_shared":             func() interface{} { return &FileSharedEvent{} },
+		"app_home_opened":         func() interface{} { return &AppHomeOpenedEvent{} },
+		"link_shared":             func() interface{} { return &LinkSharedEvent{} },
+	}
+)
+
+// RegisterType registers a go type for events of the type and subtype. The subtype can be empty to register the type
+// for all subtypes without a specific registration. The factory must return a pointer to decode the event into
+func RegisterType(eventType, subtype string, factory func() interface{}) {
+	typesMu.Lock()
+	defer typesMu.Unlock()
+
+	types[typeKey(eventType, subtype)] = factory
+}
+
+// DecodeEvent decodes inner event json into the registered go type, events of unknown types are decoded into
+// *RawEvent
+func DecodeEvent(data json.RawMessage) (interface{}, error) {
+	raw := &RawEvent{Data: data}
+	if err := json.Unmarshal(data, raw); err != nil {
+		return nil, err
+	}
+
+	typesMu.RLock()
+	factory, ok := types[typeKey(raw.Type, raw.Subtype)]
+	if !ok {
+		factory, ok = types[raw.Type]
+	}
+	typesMu.RUnlock()
+
+	if !ok {
+		return raw, nil
+	}
+
+	event := factory()
+	if err := json.Unmarshal(data, event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func typeKey(eventType, subtype string) string {
+	if len(subtype) == 0 {
+		return eventType
+	}
+
+	return eventType + "/" + subtype
+}
@@ -0,0 +1,160 @@
+package events_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kryabinin/go-slack"
+	"github.com/kryabinin/go-slack/events"
+)
+
+func TestDecodeEvent(t *testing.T) {
+	testCases := []struct {
+		name     string
+		event    string
+		expEvent interface{}
+	}{
+		{
+			name:  "message",
+			event: `{"type":"message","channel":"C1","user":"U1","text":"hi","ts":"1.2","attachments":[{"text":"a"}]}`,
+			expEvent: &events.MessageEvent{
+				Type:        "message",
+				Channel:     "C1",
+				User:        "U1",
+				Text:        "hi",
+				Timestamp:   "1.2",
+				Attachments: []slack.Attachment{{Text: "a"}},
+			},
+		},
+		{
+			name:  "message subtype without specific type",
+			event: `{"type":"message","subtype":"bot_message","bot_id":"B1","text":"hi"}`,
+			expEvent: &events.MessageEvent{
+				Type:    "message",
+				Subtype: "bot_message",
+				BotID:   "B1",
+				Text:    "hi",
+			},
+		},
+		{
+			name: "message_changed",
+			event: `{"type":"message","subtype":"message_changed","channel":"C1","hidden":true,` +
+				`"message":{"type":"message","text":"new","edited":{"user":"U1","ts":"1.3"}},` +
+				`"previous_message":{"type":"message","text":"old"}}`,
+			expEvent: &events.MessageChangedEvent{
+				Type:    "message",
+				Subtype: "message_changed",
+				Channel: "C1",
+				Hidden:  true,
+				Message: events.MessageEvent{
+					Type:   "message",
+					Text:   "new",
+					Edited: &events.Edited{User: "U1", Timestamp: "1.3"},
+				},
+				PreviousMessage: events.MessageEvent{Type: "message", Text: "old"},
+			},
+		},
+		{
+			name:  "message_deleted",
+			event: `{"type":"message","subtype":"message_deleted","channel":"C1","deleted_ts":"1.2"}`,
+			expEvent: &events.MessageDeletedEvent{
+				Type:             "message",
+				Subtype:          "message_deleted",
+				Channel:          "C1",
+				DeletedTimestamp: "1.2",
+			},
+		},
+		{
+			name:  "reaction_removed",
+			event: `{"type":"reaction_removed","user":"U1","reaction":"eyes","item":{"type":"message","channel":"C1","ts":"1.2"}}`,
+			expEvent: &events.ReactionRemovedEvent{
+				Type:     "reaction_removed",
+				User:     "U1",
+				Reaction: "eyes",
+				Item:     events.ReactionItem{Type: "message", Channel: "C1", Timestamp: "1.2"},
+			},
+		},
+		{
+			name:  "channel_rename",
+			event: `{"type":"channel_rename","channel":{"id":"C1","name":"incident-42","created":1600000000}}`,
+			expEvent: &events.ChannelRenameEvent{
+				Type:    "channel_rename",
+				Channel: events.Channel{ID: "C1", Name: "incident-42", Created: 1600000000},
+			},
+		},
+		{
+			name:  "team_join",
+			event: `{"type":"team_join","user":{"id":"U1","profile":{"email":"new@example.com"}}}`,
+			expEvent: func() interface{} {
+				event := &events.TeamJoinEvent{Type: "team_join", User: slack.User{ID: "U1"}}
+				event.User.Profile.Email = "new@example.com"
+				return event
+			}(),
+		},
+		{
+			name:  "file_shared",
+			event: `{"type":"file_shared","file_id":"F1","file":{"id":"F1"},"user_id":"U1","channel_id":"C1"}`,
+			expEvent: &events.FileSharedEvent{
+				Type:      "file_shared",
+				FileID:    "F1",
+				File:      slack.File{ID: "F1"},
+				UserID:    "U1",
+				ChannelID: "C1",
+			},
+		},
+		{
+			name:  "link_shared",
+			event: `{"type":"link_shared","channel":"C1","message_ts":"1.2","links":[{"domain":"example.com","url":"https://example.com/1"}]}`,
+			expEvent: &events.LinkSharedEvent{
+				Type:             "link_shared",
+				Channel:          "C1",
+				MessageTimestamp: "1.2",
+				Links:            []events.SharedLink{{Domain: "example.com", Url: "https://example.com/1"}},
+			},
+		},
+		{
+			name:  "unknown event",
+			event: `{"type":"emoji_changed","subtype":"add","name":"party"}`,
+			expEvent: &events.RawEvent{
+				Type:    "emoji_changed",
+				Subtype: "add",
+				Data:    json.RawMessage(`{"type":"emoji_changed","subtype":"add","name":"party"}`),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			event, err := events.DecodeEvent(json.RawMessage(testCase.event))
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expEvent, event)
+		})
+	}
+
+	t.Run("invalid json", func(t *testing.T) {
+		event, err := events.DecodeEvent(json.RawMessage(`{`))
+		assert.Error(t, err)
+		assert.Nil(t, event)
+	})
+}
+
+func TestRegisterType(t *testing.T) {
+	type testEvent struct {
+		Type    string `json:"type"`
+		Subtype string `json:"subtype"`
+		Name    string `json:"name"`
+	}
+
+	events.RegisterType("test_event", "", func() interface{} { return &testEvent{} })
+
+	event, err := events.DecodeEvent(json.RawMessage(`{"type":"test_event","subtype":"add","name":"party"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, &testEvent{Type: "test_event", Subtype: "add", Name: "party"}, event)
+}
+
+func TestReactionItem_MessageRef(t *testing.T) {
+	ref := events.ReactionItem{Type: "message", Channel: "C1", Timestamp: "1.2"}.MessageRef()
+	assert.Equal(t, slack.MessageRef{Channel: "C1", Timestamp: "1.2"}, ref)
+}
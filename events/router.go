@@ -0,0 +1,70 @@
+// Package events - events router
+package events
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+type (
+	// HandlerFunc handles an event
+	HandlerFunc func(ctx context.Context, callback Callback) error
+
+	// Router routes events to handlers registered for their types. Safe for concurrent use
+	Router struct {
+		mu       sync.RWMutex
+		handlers map[string][]HandlerFunc
+		fallback []HandlerFunc
+	}
+)
+
+// NewRouter is router constructor
+func NewRouter() *Router {
+	return &Router{handlers: make(map[string][]HandlerFunc)}
+}
+
+// On registers handler of events of the type
+func (r *Router) On(eventType string, handler HandlerFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.handlers[eventType] = append(r.handlers[eventType], handler)
+}
+
+// OnAny registers handler of events without type specific handlers
+func (r *Router) OnAny(handler HandlerFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.fallback = append(r.fallback, handler)
+}
+
+// Dispatch calls all handlers of the event and returns the first error. Panics of handlers are returned as errors
+func (r *Router) Dispatch(ctx context.Context, callback Callback) error {
+	r.mu.RLock()
+	handlers, ok := r.handlers[callback.EventType]
+	if !ok {
+		handlers = r.fallback
+	}
+	r.mu.RUnlock()
+
+	var firstErr error
+	for _, handler := range handlers {
+		if err := call(ctx, handler, callback); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+func call(ctx context.Context, handler HandlerFunc, callback Callback) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("event handler panic: %v", r)
+		}
+	}()
+
+	return handler(ctx, callback)
+}
@@ -0,0 +1,398 @@
+// Package events - event types
+package events
+
+import (
+	"encoding/json"
+
+	"github.com/kryabinin/go-slack"
+)
+
+type (
+	// MessageEvent message posted to a channel. Also used for message subtypes without a specific type, e.g.
+	// bot_message, channel_join, thread_broadcast, file_share or me_message
+	MessageEvent struct {
+		// Type always message
+		Type string `json:"type"`
+
+		// Subtype message subtype, empty for regular messages
+		Subtype string `json:"subtype"`
+
+		// Channel channel the message was posted to
+		Channel string `json:"channel"`
+
+		// ChannelType type of the channel: channel, group, im or mpim
+		ChannelType string `json:"channel_type"`
+
+		// User author of the message
+		User string `json:"user"`
+
+		// BotID bot posted the message
+		BotID string `json:"bot_id"`
+
+		// Username bot's user name
+		Username string `json:"username"`
+
+		// Team workspace of the author
+		Team string `json:"team"`
+
+		// Text message text
+		Text string `json:"text"`
+
+		// Timestamp ts value of the message
+		Timestamp string `json:"ts"`
+
+		// ThreadTimestamp ts value of the parent message if the message is a thread reply
+		ThreadTimestamp string `json:"thread_ts"`
+
+		// EventTimestamp ts value of the event
+		EventTimestamp string `json:"event_ts"`
+
+		// Hidden true for messages not shown in clients, e.g. message_changed
+		Hidden bool `json:"hidden"`
+
+		// Edited information about the last message edit
+		Edited *Edited `json:"edited,omitempty"`
+
+		// Attachments message attachments
+		Attachments []slack.Attachment `json:"attachments,omitempty"`
+
+		// Files files shared with the message
+		Files []slack.File `json:"files,omitempty"`
+
+		// Blocks message blocks json
+		Blocks json.RawMessage `json:"blocks,omitempty"`
+	}
+
+	// Edited information about a message edit
+	Edited struct {
+		// User user edited the message
+		User string `json:"user"`
+
+		// Timestamp ts value of the edit
+		Timestamp string `json:"ts"`
+	}
+
+	// MessageChangedEvent message_changed subtype of message event
+	MessageChangedEvent struct {
+		// Type always message
+		Type string `json:"type"`
+
+		// Subtype always message_changed
+		Subtype string `json:"subtype"`
+
+		// Channel channel of the message
+		Channel string `json:"channel"`
+
+		// Hidden always true
+		Hidden bool `json:"hidden"`
+
+		// Timestamp ts value of the event
+		Timestamp string `json:"ts"`
+
+		// EventTimestamp ts value of the event
+		EventTimestamp string `json:"event_ts"`
+
+		// Message the message after the change
+		Message MessageEvent `json:"message"`
+
+		// PreviousMessage the message before the change
+		PreviousMessage MessageEvent `json:"previous_message"`
+	}
+
+	// MessageDeletedEvent message_deleted subtype of message event
+	MessageDeletedEvent struct {
+		// Type always message
+		Type string `json:"type"`
+
+		// Subtype always message_deleted
+		Subtype string `json:"subtype"`
+
+		// Channel channel of the message
+		Channel string `json:"channel"`
+
+		// Hidden always true
+		Hidden bool `json:"hidden"`
+
+		// Timestamp ts value of the event
+		Timestamp string `json:"ts"`
+
+		// EventTimestamp ts value of the event
+		EventTimestamp string `json:"event_ts"`
+
+		// DeletedTimestamp ts value of the deleted message
+		DeletedTimestamp string `json:"deleted_ts"`
+
+		// PreviousMessage the deleted message
+		PreviousMessage MessageEvent `json:"previous_message"`
+	}
+
+	// AppMentionEvent the app is mentioned in a message
+	AppMentionEvent struct {
+		// Type always app_mention
+		Type string `json:"type"`
+
+		// User author of the message
+		User string `json:"user"`
+
+		// Text message text
+		Text string `json:"text"`
+
+		// Channel channel the message was posted to
+		Channel string `json:"channel"`
+
+		// Timestamp ts value of the message
+		Timestamp string `json:"ts"`
+
+		// ThreadTimestamp ts value of the parent message if the message is a thread reply
+		ThreadTimestamp string `json:"thread_ts"`
+
+		// EventTimestamp ts value of the event
+		EventTimestamp string `json:"event_ts"`
+	}
+
+	// ReactionAddedEvent a reaction is added to an item
+	ReactionAddedEvent struct {
+		// Type reaction_added or reaction_removed
+		Type string `json:"type"`
+
+		// User user reacted to the item
+		User string `json:"user"`
+
+		// Reaction emoji name without colons
+		Reaction string `json:"reaction"`
+
+		// ItemUser author of the item
+		ItemUser string `json:"item_user"`
+
+		// Item reacted item
+		Item ReactionItem `json:"item"`
+
+		// EventTimestamp ts value of the event
+		EventTimestamp string `json:"event_ts"`
+	}
+
+	// ReactionRemovedEvent a reaction is removed from an item
+	ReactionRemovedEvent ReactionAddedEvent
+
+	// ReactionItem item of a reaction event
+	ReactionItem struct {
+		// Type type of the item: message, file or file_comment
+		Type string `json:"type"`
+
+		// Channel channel of the message
+		Channel string `json:"channel"`
+
+		// Timestamp ts value of the message
+		Timestamp string `json:"ts"`
+
+		// File file identifier
+		File string `json:"file"`
+
+		// FileComment file comment identifier
+		FileComment string `json:"file_comment"`
+	}
+
+	// MemberJoinedChannelEvent a user joined a channel
+	MemberJoinedChannelEvent struct {
+		// Type always member_joined_channel
+		Type string `json:"type"`
+
+		// User user joined the channel
+		User string `json:"user"`
+
+		// Channel joined channel
+		Channel string `json:"channel"`
+
+		// ChannelType type of the channel: C for public and G for private channels
+		ChannelType string `json:"channel_type"`
+
+		// Team workspace of the user
+		Team string `json:"team"`
+
+		// Inviter user invited the member, empty if the user joined by themselves
+		Inviter string `json:"inviter"`
+
+		// EventTimestamp ts value of the event
+		EventTimestamp string `json:"event_ts"`
+	}
+
+	// ChannelCreatedEvent a channel is created
+	ChannelCreatedEvent struct {
+		// Type always channel_created
+		Type string `json:"type"`
+
+		// Channel created channel
+		Channel Channel `json:"channel"`
+
+		// EventTimestamp ts value of the event
+		EventTimestamp string `json:"event_ts"`
+	}
+
+	// ChannelRenameEvent a channel is renamed
+	ChannelRenameEvent struct {
+		// Type always channel_rename
+		Type string `json:"type"`
+
+		// Channel renamed channel with the new name
+		Channel Channel `json:"channel"`
+
+		// EventTimestamp ts value of the event
+		EventTimestamp string `json:"event_ts"`
+	}
+
+	// ChannelArchiveEvent a channel is archived
+	ChannelArchiveEvent struct {
+		// Type always channel_archive
+		Type string `json:"type"`
+
+		// Channel archived channel identifier
+		Channel string `json:"channel"`
+
+		// User user archived the channel
+		User string `json:"user"`
+
+		// IsMoved true if the channel is moved to another workspace
+		IsMoved int `json:"is_moved"`
+
+		// EventTimestamp ts value of the event
+		EventTimestamp string `json:"event_ts"`
+	}
+
+	// Channel channel of channel events
+	Channel struct {
+		// ID channel identifier
+		ID string `json:"id"`
+
+		// Name channel name
+		Name string `json:"name"`
+
+		// Created a unix timestamp when the channel was created
+		Created int64 `json:"created"`
+
+		// Creator user created the channel
+		Creator string `json:"creator"`
+	}
+
+	// UserChangeEvent a user's data has changed
+	UserChangeEvent struct {
+		// Type always user_change
+		Type string `json:"type"`
+
+		// User the changed user
+		User slack.User `json:"user"`
+
+		// CacheTimestamp ts value of the user cache
+		CacheTimestamp int64 `json:"cache_ts"`
+
+		// EventTimestamp ts value of the event
+		EventTimestamp string `json:"event_ts"`
+	}
+
+	// TeamJoinEvent a new member joined the workspace
+	TeamJoinEvent struct {
+		// Type always team_join
+		Type string `json:"type"`
+
+		// User the new member
+		User slack.User `json:"user"`
+
+		// EventTimestamp ts value of the event
+		EventTimestamp string `json:"event_ts"`
+	}
+
+	// FileSharedEvent a file is shared
+	FileSharedEvent struct {
+		// Type always file_shared
+		Type string `json:"type"`
+
+		// FileID shared file identifier
+		FileID string `json:"file_id"`
+
+		// File shared file, contains only identifier, use files.info to get details
+		File slack.File `json:"file"`
+
+		// UserID user shared the file
+		UserID string `json:"user_id"`
+
+		// ChannelID channel the file is shared to
+		ChannelID string `json:"channel_id"`
+
+		// EventTimestamp ts value of the event
+		EventTimestamp string `json:"event_ts"`
+	}
+
+	// AppHomeOpenedEvent a user opened the app home
+	AppHomeOpenedEvent struct {
+		// Type always app_home_opened
+		Type string `json:"type"`
+
+		// User user opened the app home
+		User string `json:"user"`
+
+		// Channel direct message channel with the app
+		Channel string `json:"channel"`
+
+		// Tab opened tab: home or messages
+		Tab string `json:"tab"`
+
+		// View json of the home view, set only if it was published
+		View json.RawMessage `json:"view,omitempty"`
+
+		// EventTimestamp ts value of the event
+		EventTimestamp string `json:"event_ts"`
+	}
+
+	// LinkSharedEvent a message with links of the app domains is posted
+	LinkSharedEvent struct {
+		// Type always link_shared
+		Type string `json:"type"`
+
+		// Channel channel of the message
+		Channel string `json:"channel"`
+
+		// User author of the message
+		User string `json:"user"`
+
+		// MessageTimestamp ts value of the message
+		MessageTimestamp string `json:"message_ts"`
+
+		// ThreadTimestamp ts value of the parent message if the message is a thread reply
+		ThreadTimestamp string `json:"thread_ts"`
+
+		// Links shared links
+		Links []SharedLink `json:"links"`
+
+		// UnfurlID identifier to unfurl the links with
+		UnfurlID string `json:"unfurl_id"`
+
+		// Source where the links are shared: conversations_history or composer
+		Source string `json:"source"`
+
+		// EventTimestamp ts value of the event
+		EventTimestamp string `json:"event_ts"`
+	}
+
+	// SharedLink link of link_shared event
+	SharedLink struct {
+		// Domain domain of the link
+		Domain string `json:"domain"`
+
+		// Url the link
+		Url string `json:"url"`
+	}
+)
+
+// MessageRef returns reference to the reacted message
+func (i ReactionItem) MessageRef() slack.MessageRef {
+	return slack.MessageRef{Channel: i.Channel, Timestamp: i.Timestamp}
+}
+
+// MessageRef returns reference to the message
+func (e MessageEvent) MessageRef() slack.MessageRef {
+	return slack.MessageRef{Channel: e.Channel, Timestamp: e.Timestamp}
+}
+
+// MessageRef returns reference to the message mentioned the app
+func (e AppMentionEvent) MessageRef() slack.MessageRef {
+	return slack.MessageRef{Channel: e.Channel, Timestamp: e.Timestamp}
+}
@@ -0,0 +1,293 @@
+// Package slack - files
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ErrInvalidFileURL the download url is not a slack files url, the token is never sent to other hosts
+var ErrInvalidFileURL = errors.New("url is not a slack files url")
+
+var fileHosts = map[string]bool{
+	"files.slack.com":     true,
+	"files.slack-gov.com": true,
+}
+
+type (
+	// File entity
+	File struct {
+		// ID file identifier
+		ID string `json:"id"`
+
+		// Created a unix timestamp when the file was created
+		Created int64 `json:"created"`
+
+		// Name file name
+		Name string `json:"name"`
+
+		// Title file title
+		Title string `json:"title"`
+
+		// Mimetype mime type of the file
+		Mimetype string `json:"mimetype"`
+
+		// Filetype type of the file, e.g. png, text or pdf
+		Filetype string `json:"filetype"`
+
+		// User user who uploaded the file
+		User string `json:"user"`
+
+		// Team workspace the file belongs to
+		Team string `json:"team"`
+
+		// EnterpriseID Enterprise Grid organization of the file, empty outside of Enterprise Grid
+		EnterpriseID string `json:"enterprise_id"`
+
+		// UserTeam workspace of the user who uploaded the file
+		UserTeam string `json:"user_team"`
+
+		// SourceTeam workspace the file was uploaded to
+		SourceTeam string `json:"source_team"`
+
+		// Size file size in bytes
+		Size int64 `json:"size"`
+
+		// IsPublic true if the file is shared to a public channel
+		IsPublic bool `json:"is_public"`
+
+		// UrlPrivate url of the file content, requires an authorization token
+		UrlPrivate string `json:"url_private"`
+
+		// UrlPrivateDownload url to download the file content, requires an authorization token
+		UrlPrivateDownload string `json:"url_private_download"`
+
+		// Permalink permanent url of the file page
+		Permalink string `json:"permalink"`
+
+		// PermalinkPublic public url of the file, works only when the file is shared publicly
+		PermalinkPublic string `json:"permalink_public"`
+
+		// PublicUrlShared true if the file is shared publicly
+		PublicUrlShared bool `json:"public_url_shared"`
+
+		// Channels public channels the file is shared to
+		Channels []string `json:"channels"`
+
+		// Groups private channels the file is shared to
+		Groups []string `json:"groups"`
+
+		// Ims direct message channels the file is shared to
+		Ims []string `json:"ims"`
+
+		// AltText description of the image for screen readers
+		AltText string `json:"alt_txt"`
+	}
+
+	// FilesList page of files
+	FilesList struct {
+		// Files list of files
+		Files []File `json:"files"`
+
+		// Paging pagination information
+		Paging Paging `json:"paging"`
+	}
+
+	// Paging page based pagination information
+	Paging struct {
+		// Count number of items per page
+		Count int `json:"count"`
+
+		// Total total number of items
+		Total int `json:"total"`
+
+		// Page current page number
+		Page int `json:"page"`
+
+		// Pages total number of pages
+		Pages int `json:"pages"`
+	}
+
+	fileRequest struct {
+		File string `json:"file"`
+	}
+
+	fileApiResponse struct {
+		// Ok indicates success or failure
+		Ok bool `json:"ok"`
+
+		// Error short machine-readable error code
+		Error string `json:"error"`
+
+		// File requested file
+		File File `json:"file"`
+
+		FilesList
+	}
+)
+
+func getFileInfo(ctx context.Context, c *client, fileID string) (File, error) {
+	respBody, err := c.get(ctx, "files.info?"+url.Values{"file": {fileID}}.Encode())
+	if err != nil {
+		return File{}, err
+	}
+
+	resp, err := decodeFileResponse(respBody)
+	if err != nil {
+		return File{}, err
+	}
+
+	return resp.File, nil
+}
+
+func listFiles(ctx context.Context, c *client, opts ...ListOption) (FilesList, error) {
+	respBody, err := c.get(ctx, "files.list?"+listParams(opts).Encode())
+	if err != nil {
+		return FilesList{}, err
+	}
+
+	resp, err := decodeFileResponse(respBody)
+	if err != nil {
+		return FilesList{}, err
+	}
+
+	return resp.FilesList, nil
+}
+
+func deleteFile(ctx context.Context, c *client, fileID string) error {
+	_, err := sendFileRequest(ctx, c, "files.delete", fileID)
+
+	return err
+}
+
+func shareFilePublicURL(ctx context.Context, c *client, fileID string) (File, error) {
+	resp, err := sendFileRequest(ctx, c, "files.sharedPublicURL", fileID)
+	if err != nil {
+		return File{}, err
+	}
+
+	return resp.File, nil
+}
+
+func revokeFilePublicURL(ctx context.Context, c *client, fileID string) (File, error) {
+	resp, err := sendFileRequest(ctx, c, "files.revokePublicURL", fileID)
+	if err != nil {
+		return File{}, err
+	}
+
+	return resp.File, nil
+}
+
+func downloadFile(ctx context.Context, c *client, file File, w io.Writer) error {
+	if len(file.UrlPrivateDownload) == 0 {
+		return errors.New("file has no download url")
+	}
+
+	if err := validateFileURL(file.UrlPrivateDownload); err != nil {
+		return err
+	}
+
+	token, err := c.tokenSource.Token(ctx)
+	if err != nil {
+		return fmt.Errorf("can't get token: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, file.UrlPrivateDownload, nil)
+	if nil != err {
+		return fmt.Errorf("can't create http request: %w", err)
+	}
+
+	req = req.WithContext(ctx)
+	req.Header.Add("Authorization", "Bearer "+token)
+
+	apiReq := &Request{
+		Method:        MethodDownloadContent,
+		Params:        url.Values{"file": {file.ID}},
+		TokenType:     DetectTokenType(token),
+		TokenIdentity: maskToken(token),
+		Attempt:       1,
+		HTTPRequest:   req,
+	}
+
+	waitStart := time.Now()
+	if err = c.rateLimiter.Wait(ctx); err != nil {
+		return fmt.Errorf("can't wait for rate limit: %w", err)
+	}
+
+	apiReq.RateLimitWait = time.Since(waitStart)
+
+	var resp *http.Response
+	if resp, err = c.roundTrip(ctx, apiReq); nil != err {
+		return fmt.Errorf("can't send http request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if http.StatusTooManyRequests == resp.StatusCode {
+		delay := retryAfter(resp.Header)
+		c.rateLimiter.block(delay)
+
+		return &RateLimitedError{RetryAfter: delay}
+	}
+
+	if http.StatusOK != resp.StatusCode {
+		return fmt.Errorf("slack respond with %d status code", resp.StatusCode)
+	}
+
+	// slack responds with its login page instead of the content if the token can't access the file
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), "text/html") {
+		return fmt.Errorf("can't download file %s: slack respond with html page, check files:read scope", file.ID)
+	}
+
+	if _, err = io.Copy(w, resp.Body); err != nil {
+		return fmt.Errorf("can't download file %s: %w", file.ID, err)
+	}
+
+	return nil
+}
+
+func validateFileURL(fileURL string) error {
+	u, err := url.Parse(fileURL)
+	if err != nil {
+		return fmt.Errorf("can't parse url: %w", err)
+	}
+
+	if u.Scheme != "https" || !fileHosts[u.Hostname()] {
+		return fmt.Errorf("%s: %w", u.Host, ErrInvalidFileURL)
+	}
+
+	return nil
+}
+
+func sendFileRequest(ctx context.Context, c *client, method, fileID string) (fileApiResponse, error) {
+	data, err := json.Marshal(fileRequest{File: fileID})
+	if err != nil {
+		return fileApiResponse{}, fmt.Errorf("can't marshal request: %w", err)
+	}
+
+	respBody, err := c.post(ctx, method, data)
+	if err != nil {
+		return fileApiResponse{}, err
+	}
+
+	return decodeFileResponse(respBody)
+}
+
+func decodeFileResponse(respBody []byte) (fileApiResponse, error) {
+	var resp fileApiResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return fileApiResponse{}, fmt.Errorf("can't unmarshal response: %w", err)
+	}
+
+	if !resp.Ok {
+		return fileApiResponse{}, fmt.Errorf("slack respond with error: %s", resp.Error)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,259 @@
+package slack_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-slack"
+)
+
+func TestClient_GetFileInfo(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		baseUrl := "http://test.slack.com/api"
+
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Equal(t, baseUrl+"/"+"files.info?file=F1", req.URL.String())
+		}).Return(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewReader([]byte(
+				`{"ok":true,"file":{"id":"F1","name":"a.png","size":5,"channels":["C1"]}}`))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		c := slack.NewClient("test_token", slack.WithBaseUrl(baseUrl), slack.WithHttpClient(httpClient))
+
+		file, err := c.GetFileInfo(context.Background(), "F1")
+		assert.NoError(t, err)
+		assert.Equal(t, slack.File{ID: "F1", Name: "a.png", Size: 5, Channels: []string{"C1"}}, file)
+	})
+
+	t.Run("slack respond with error", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":false,"error":"file_not_found"}`))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		c := slack.NewClient("test_token", slack.WithHttpClient(httpClient))
+
+		file, err := c.GetFileInfo(context.Background(), "F1")
+		assert.Error(t, err)
+		assert.Equal(t, slack.File{}, file)
+	})
+}
+
+func TestClient_ListFiles(t *testing.T) {
+	baseUrl := "http://test.slack.com/api"
+
+	httpClient := new(slack.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, baseUrl+"/"+"files.list?channel=C1&count=20&page=2&ts_from=1600000000&ts_to=1600003600"+
+			"&types=images%2Cpdfs&user=U1", req.URL.String())
+	}).Return(&http.Response{
+		Body: ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":true,"files":[{"id":"F1"}],` +
+			`"paging":{"count":20,"total":21,"page":2,"pages":2}}`))),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	c := slack.NewClient("test_token", slack.WithBaseUrl(baseUrl), slack.WithHttpClient(httpClient))
+
+	from := time.Unix(1600000000, 0)
+	files, err := c.ListFiles(
+		context.Background(),
+		slack.ByChannel("C1"),
+		slack.ByUser("U1"),
+		slack.ByTypes("images", "pdfs"),
+		slack.From(from),
+		slack.To(from.Add(time.Hour)),
+		slack.Page(2),
+		slack.Count(20),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, slack.FilesList{
+		Files:  []slack.File{{ID: "F1"}},
+		Paging: slack.Paging{Count: 20, Total: 21, Page: 2, Pages: 2},
+	}, files)
+}
+
+func TestClient_DeleteFile(t *testing.T) {
+	baseUrl := "http://test.slack.com/api"
+
+	httpClient := new(slack.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, baseUrl+"/"+"files.delete", req.URL.String())
+
+		request, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"file":"F1"}`, string(request))
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":true}`))),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	c := slack.NewClient("test_token", slack.WithBaseUrl(baseUrl), slack.WithHttpClient(httpClient))
+
+	assert.NoError(t, c.DeleteFile(context.Background(), "F1"))
+}
+
+func TestClient_ShareFilePublicURL(t *testing.T) {
+	baseUrl := "http://test.slack.com/api"
+
+	httpClient := new(slack.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, baseUrl+"/"+"files.sharedPublicURL", req.URL.String())
+	}).Return(&http.Response{
+		Body: ioutil.NopCloser(bytes.NewReader([]byte(
+			`{"ok":true,"file":{"id":"F1","public_url_shared":true,"permalink_public":"https://slack-files.com/F1"}}`))),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	c := slack.NewClient("test_token", slack.WithBaseUrl(baseUrl), slack.WithHttpClient(httpClient))
+
+	file, err := c.ShareFilePublicURL(context.Background(), "F1")
+	assert.NoError(t, err)
+	assert.True(t, file.PublicUrlShared)
+	assert.Equal(t, "https://slack-files.com/F1", file.PermalinkPublic)
+}
+
+func TestClient_RevokeFilePublicURL(t *testing.T) {
+	baseUrl := "http://test.slack.com/api"
+
+	httpClient := new(slack.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, baseUrl+"/"+"files.revokePublicURL", req.URL.String())
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":true,"file":{"id":"F1","public_url_shared":false}}`))),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	c := slack.NewClient("test_token", slack.WithBaseUrl(baseUrl), slack.WithHttpClient(httpClient))
+
+	file, err := c.RevokeFilePublicURL(context.Background(), "F1")
+	assert.NoError(t, err)
+	assert.False(t, file.PublicUrlShared)
+}
+
+func TestClient_DownloadFile(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		var (
+			token       = "test_token"
+			downloadUrl = "https://files.slack.com/files-pri/T1-F1/download/a.txt"
+		)
+
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Equal(t, downloadUrl, req.URL.String())
+			assert.Equal(t, "Bearer "+token, req.Header.Get("Authorization"))
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("file content"))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		c := slack.NewClient(token, slack.WithHttpClient(httpClient))
+
+		var buf bytes.Buffer
+		err := c.DownloadFile(context.Background(), slack.File{ID: "F1", UrlPrivateDownload: downloadUrl}, &buf)
+		assert.NoError(t, err)
+		assert.Equal(t, "file content", buf.String())
+	})
+
+	t.Run("no download url", func(t *testing.T) {
+		c := slack.NewClient("test_token", slack.WithHttpClient(new(slack.MockHTTPClient)))
+
+		err := c.DownloadFile(context.Background(), slack.File{ID: "F1"}, new(bytes.Buffer))
+		assert.Error(t, err)
+	})
+
+	t.Run("non 200 status", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+			StatusCode: http.StatusNotFound,
+		}, nil)
+
+		c := slack.NewClient("test_token", slack.WithHttpClient(httpClient))
+
+		var buf bytes.Buffer
+		err := c.DownloadFile(context.Background(), slack.File{ID: "F1", UrlPrivateDownload: "https://files.slack.com/f"},
+			&buf)
+		assert.Error(t, err)
+		assert.Equal(t, "slack respond with 404 status code", err.Error())
+		assert.Zero(t, buf.Len())
+	})
+
+	t.Run("foreign host", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		c := slack.NewClient("test_token", slack.WithHttpClient(httpClient))
+
+		for _, downloadUrl := range []string{"https://evil.example.com/f", "http://files.slack.com/f"} {
+			err := c.DownloadFile(context.Background(), slack.File{ID: "F1", UrlPrivateDownload: downloadUrl},
+				new(bytes.Buffer))
+			assert.True(t, errors.Is(err, slack.ErrInvalidFileURL))
+		}
+		httpClient.AssertNotCalled(t, "Do", mock.Anything)
+	})
+
+	t.Run("login page", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Header:     http.Header{"Content-Type": {"text/html; charset=utf-8"}},
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("<html>sign in</html>"))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		c := slack.NewClient("test_token", slack.WithHttpClient(httpClient))
+
+		var buf bytes.Buffer
+		err := c.DownloadFile(context.Background(), slack.File{ID: "F1", UrlPrivateDownload: "https://files.slack.com/f"},
+			&buf)
+		assert.Error(t, err)
+		assert.Zero(t, buf.Len())
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Header:     http.Header{"Retry-After": {"3"}},
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+			StatusCode: http.StatusTooManyRequests,
+		}, nil)
+
+		c := slack.NewClient("test_token", slack.WithHttpClient(httpClient))
+
+		err := c.DownloadFile(context.Background(), slack.File{ID: "F1", UrlPrivateDownload: "https://files.slack.com/f"},
+			new(bytes.Buffer))
+
+		var rateErr *slack.RateLimitedError
+		assert.True(t, errors.As(err, &rateErr))
+		assert.Equal(t, 3*time.Second, rateErr.RetryAfter)
+	})
+}
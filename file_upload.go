@@ -0,0 +1,265 @@
+// Package slack - file uploads
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+type (
+	// FileUpload file to upload
+	FileUpload struct {
+		// Reader content of the file. It is streamed to slack, not read into memory
+		Reader io.Reader
+
+		// Name file name. Detected from the reader for *os.File
+		Name string
+
+		// Title file title, file name by default
+		Title string
+
+		// Size file size in bytes. Detected from the reader for *os.File, *bytes.Reader, *strings.Reader etc.
+		Size int64
+
+		// AltText description of the image for screen readers
+		AltText string
+
+		// SnippetType syntax type of the snippet being uploaded, e.g. go or python
+		SnippetType string
+	}
+
+	// PartialUploadError not every file of a multi-file upload was uploaded. The uploaded files are completed without
+	// sharing them to the channel, share or delete them and retry the rest
+	PartialUploadError struct {
+		// Files completed files, they are not shared
+		Files []File
+
+		// Failed files which weren't uploaded, starting with the one failed with Err
+		Failed []FileUpload
+
+		// Err error of the failed upload
+		Err error
+	}
+
+	uploadRequest struct {
+		files          []FileUpload
+		channel        string
+		initialComment string
+		threadTs       string
+	}
+
+	uploadedFile struct {
+		ID    string `json:"id"`
+		Title string `json:"title,omitempty"`
+	}
+
+	completeUploadRequest struct {
+		Files          []uploadedFile `json:"files"`
+		ChannelID      string         `json:"channel_id,omitempty"`
+		InitialComment string         `json:"initial_comment,omitempty"`
+		ThreadTs       string         `json:"thread_ts,omitempty"`
+	}
+
+	uploadApiResponse struct {
+		// Ok indicates success or failure
+		Ok bool `json:"ok"`
+
+		// Error short machine-readable error code
+		Error string `json:"error"`
+
+		// UploadUrl url to upload the file content to
+		UploadUrl string `json:"upload_url"`
+
+		// FileID id of the file being uploaded
+		FileID string `json:"file_id"`
+
+		// Files completed files
+		Files []File `json:"files"`
+	}
+)
+
+func uploadFile(ctx context.Context, c *client, r io.Reader, opts ...UploadOption) ([]File, error) {
+	req := uploadRequest{files: []FileUpload{{Reader: r}}}
+
+	for _, opt := range opts {
+		opt.apply(&req)
+	}
+
+	complete := completeUploadRequest{
+		Files:          make([]uploadedFile, 0, len(req.files)),
+		ChannelID:      req.channel,
+		InitialComment: req.initialComment,
+		ThreadTs:       req.threadTs,
+	}
+
+	for i, file := range req.files {
+		fileID, err := uploadFileContent(ctx, c, file)
+		if err != nil {
+			if i == 0 {
+				return nil, err
+			}
+
+			// uploaded files are deleted by slack unless they are completed, complete them to not lose them
+			files, completeErr := completeUpload(ctx, c, completeUploadRequest{Files: complete.Files})
+			if completeErr != nil {
+				return nil, err
+			}
+
+			return files, &PartialUploadError{Files: files, Failed: req.files[i:], Err: err}
+		}
+
+		complete.Files = append(complete.Files, uploadedFile{ID: fileID, Title: file.Title})
+	}
+
+	return completeUpload(ctx, c, complete)
+}
+
+// Error implementation
+func (e *PartialUploadError) Error() string {
+	return fmt.Sprintf("can't upload %d of %d files: %s", len(e.Failed), len(e.Files)+len(e.Failed), e.Err)
+}
+
+// Unwrap returns the error of the failed upload
+func (e *PartialUploadError) Unwrap() error {
+	return e.Err
+}
+
+func completeUpload(ctx context.Context, c *client, complete completeUploadRequest) ([]File, error) {
+	data, err := json.Marshal(complete)
+	if err != nil {
+		return nil, fmt.Errorf("can't marshal request: %w", err)
+	}
+
+	respBody, err := c.post(ctx, "files.completeUploadExternal", data)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := decodeUploadResponse(respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Files, nil
+}
+
+func uploadFileContent(ctx context.Context, c *client, file FileUpload) (string, error) {
+	if file.Reader == nil {
+		return "", errors.New("file reader is required")
+	}
+
+	if len(file.Name) == 0 {
+		file.Name = readerName(file.Reader)
+	}
+
+	if len(file.Name) == 0 {
+		return "", errors.New("file name is required")
+	}
+
+	if file.Size == 0 {
+		file.Size = readerSize(file.Reader)
+	}
+
+	if file.Size <= 0 {
+		return "", fmt.Errorf("size of file %s is required", file.Name)
+	}
+
+	params := url.Values{
+		"filename": {file.Name},
+		"length":   {strconv.FormatInt(file.Size, 10)},
+	}
+
+	if len(file.AltText) > 0 {
+		params.Set("alt_txt", file.AltText)
+	}
+
+	if len(file.SnippetType) > 0 {
+		params.Set("snippet_type", file.SnippetType)
+	}
+
+	respBody, err := c.get(ctx, "files.getUploadURLExternal?"+params.Encode())
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := decodeUploadResponse(respBody)
+	if err != nil {
+		return "", err
+	}
+
+	// the upload url is pre-signed, the token only identifies the client for middlewares
+	token, err := c.tokenSource.Token(ctx)
+	if err != nil {
+		return "", fmt.Errorf("can't get token: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, resp.UploadUrl, io.LimitReader(file.Reader, file.Size))
+	if nil != err {
+		return "", fmt.Errorf("can't create http request: %w", err)
+	}
+
+	req = req.WithContext(ctx)
+	req.ContentLength = file.Size
+	req.Header.Add("Content-Type", "application/octet-stream")
+
+	var uploadResp *http.Response
+	if uploadResp, err = c.roundTrip(ctx, &Request{
+		Method:        MethodUploadContent,
+		Params:        params,
+		TokenType:     DetectTokenType(token),
+		TokenIdentity: maskToken(token),
+		Attempt:       1,
+		HTTPRequest:   req,
+	}); nil != err {
+		return "", fmt.Errorf("can't upload file %s: %w", file.Name, err)
+	}
+	defer uploadResp.Body.Close()
+
+	if http.StatusOK != uploadResp.StatusCode {
+		return "", fmt.Errorf("slack respond with %d status code on file upload", uploadResp.StatusCode)
+	}
+
+	return resp.FileID, nil
+}
+
+func decodeUploadResponse(respBody []byte) (uploadApiResponse, error) {
+	var resp uploadApiResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return uploadApiResponse{}, fmt.Errorf("can't unmarshal response: %w", err)
+	}
+
+	if !resp.Ok {
+		return uploadApiResponse{}, fmt.Errorf("slack respond with error: %s", resp.Error)
+	}
+
+	return resp, nil
+}
+
+func readerName(r io.Reader) string {
+	if named, ok := r.(interface{ Name() string }); ok {
+		return filepath.Base(named.Name())
+	}
+
+	return ""
+}
+
+func readerSize(r io.Reader) int64 {
+	switch v := r.(type) {
+	case interface{ Len() int }:
+		return int64(v.Len())
+	case interface{ Stat() (os.FileInfo, error) }:
+		if info, err := v.Stat(); err == nil && info.Mode().IsRegular() {
+			return info.Size()
+		}
+	}
+
+	return 0
+}
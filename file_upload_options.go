@@ -0,0 +1,126 @@
+// Package slack - file upload options
+package slack
+
+type (
+	// UploadOption to apply optional parameters to file upload
+	UploadOption interface {
+		apply(req *uploadRequest)
+	}
+
+	fileName struct {
+		name string
+	}
+
+	fileTitle struct {
+		title string
+	}
+
+	fileSize struct {
+		size int64
+	}
+
+	fileAltText struct {
+		altText string
+	}
+
+	fileSnippetType struct {
+		snippetType string
+	}
+
+	addFile struct {
+		file FileUpload
+	}
+
+	shareToChannel struct {
+		channel string
+	}
+
+	inThread struct {
+		threadTs string
+	}
+
+	initialComment struct {
+		comment string
+	}
+)
+
+// FileName sets name of the uploaded file
+func FileName(name string) UploadOption {
+	return &fileName{name: name}
+}
+
+func (opt *fileName) apply(req *uploadRequest) {
+	req.files[0].Name = opt.name
+}
+
+// FileTitle sets title of the uploaded file
+func FileTitle(title string) UploadOption {
+	return &fileTitle{title: title}
+}
+
+func (opt *fileTitle) apply(req *uploadRequest) {
+	req.files[0].Title = opt.title
+}
+
+// FileSize sets size of the uploaded file in bytes. Required when it can't be detected from the reader
+func FileSize(size int64) UploadOption {
+	return &fileSize{size: size}
+}
+
+func (opt *fileSize) apply(req *uploadRequest) {
+	req.files[0].Size = opt.size
+}
+
+// FileAltText sets description of the uploaded image for screen readers
+func FileAltText(altText string) UploadOption {
+	return &fileAltText{altText: altText}
+}
+
+func (opt *fileAltText) apply(req *uploadRequest) {
+	req.files[0].AltText = opt.altText
+}
+
+// FileSnippetType uploads the file as a snippet with the syntax type, e.g. go or python
+func FileSnippetType(snippetType string) UploadOption {
+	return &fileSnippetType{snippetType: snippetType}
+}
+
+func (opt *fileSnippetType) apply(req *uploadRequest) {
+	req.files[0].SnippetType = opt.snippetType
+}
+
+// AddFile uploads one more file within the same upload
+func AddFile(file FileUpload) UploadOption {
+	return &addFile{file: file}
+}
+
+func (opt *addFile) apply(req *uploadRequest) {
+	req.files = append(req.files, opt.file)
+}
+
+// ShareToChannel shares uploaded files to the channel
+func ShareToChannel(channel string) UploadOption {
+	return &shareToChannel{channel: channel}
+}
+
+func (opt *shareToChannel) apply(req *uploadRequest) {
+	req.channel = opt.channel
+}
+
+// InThread shares uploaded files as a reply to the thread, must be used together with ShareToChannel
+func InThread(threadTs string) UploadOption {
+	return &inThread{threadTs: threadTs}
+}
+
+func (opt *inThread) apply(req *uploadRequest) {
+	req.threadTs = opt.threadTs
+}
+
+// InitialComment sets the message text introducing the shared files
+func InitialComment(comment string) UploadOption {
+	return &initialComment{comment: comment}
+}
+
+func (opt *initialComment) apply(req *uploadRequest) {
+	req.initialComment = opt.comment
+}
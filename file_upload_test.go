@@ -0,0 +1,201 @@
+package slack_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-slack"
+)
+
+func TestClient_UploadFile(t *testing.T) {
+	methodIs := func(method string) interface{} {
+		return mock.MatchedBy(func(req *http.Request) bool {
+			return strings.HasPrefix(req.URL.Path, "/api/"+method)
+		})
+	}
+
+	t.Run("positive case", func(t *testing.T) {
+		baseUrl := "http://test.slack.com/api"
+
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", methodIs("files.getUploadURLExternal")).Run(func(args mock.Arguments) {
+			req := args.Get(0).(*http.Request)
+
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Equal(t, baseUrl+"/"+"files.getUploadURLExternal?alt_txt=diagram&filename=a.png&length=5",
+				req.URL.String())
+		}).Return(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewReader([]byte(
+				`{"ok":true,"upload_url":"http://files.slack.com/upload/a","file_id":"F1"}`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+		httpClient.On("Do", methodIs("files.getUploadURLExternal")).Return(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewReader([]byte(
+				`{"ok":true,"upload_url":"http://files.slack.com/upload/b","file_id":"F2"}`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+		httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+			return req.URL.Host == "files.slack.com"
+		})).Run(func(args mock.Arguments) {
+			req := args.Get(0).(*http.Request)
+
+			content, err := ioutil.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, int64(len(content)), req.ContentLength)
+
+			switch req.URL.Path {
+			case "/upload/a":
+				assert.Equal(t, "hello", string(content))
+			case "/upload/b":
+				assert.Equal(t, "package main", string(content))
+			default:
+				t.Errorf("unexpected upload url %s", req.URL)
+			}
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("OK"))),
+			StatusCode: http.StatusOK,
+		}, nil).Twice()
+		httpClient.On("Do", methodIs("files.completeUploadExternal")).Run(func(args mock.Arguments) {
+			req := args.Get(0).(*http.Request)
+
+			request, err := ioutil.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"files":[{"id":"F1","title":"Diagram"},{"id":"F2"}],`+
+				`"channel_id":"C1","thread_ts":"1.2","initial_comment":"see attached"}`, string(request))
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":true,"files":[{"id":"F1"},{"id":"F2"}]}`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		c := slack.NewClient("test_token", slack.WithBaseUrl(baseUrl), slack.WithHttpClient(httpClient))
+
+		files, err := c.UploadFile(
+			context.Background(),
+			strings.NewReader("hello"),
+			slack.FileName("a.png"),
+			slack.FileTitle("Diagram"),
+			slack.FileAltText("diagram"),
+			slack.AddFile(slack.FileUpload{
+				Reader:      strings.NewReader("package main"),
+				Name:        "main.go",
+				SnippetType: "go",
+			}),
+			slack.ShareToChannel("C1"),
+			slack.InThread("1.2"),
+			slack.InitialComment("see attached"),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, []slack.File{{ID: "F1"}, {ID: "F2"}}, files)
+		httpClient.AssertExpectations(t)
+	})
+
+	t.Run("file name is required", func(t *testing.T) {
+		c := slack.NewClient("test_token", slack.WithHttpClient(new(slack.MockHTTPClient)))
+
+		files, err := c.UploadFile(context.Background(), strings.NewReader("hello"))
+		assert.Error(t, err)
+		assert.Nil(t, files)
+	})
+
+	t.Run("file size is required", func(t *testing.T) {
+		c := slack.NewClient("test_token", slack.WithHttpClient(new(slack.MockHTTPClient)))
+
+		files, err := c.UploadFile(
+			context.Background(),
+			ioutil.NopCloser(strings.NewReader("hello")),
+			slack.FileName("a.txt"),
+		)
+		assert.Error(t, err)
+		assert.Nil(t, files)
+	})
+
+	t.Run("error on upload", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", methodIs("files.getUploadURLExternal")).Return(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewReader([]byte(
+				`{"ok":true,"upload_url":"http://files.slack.com/upload/a","file_id":"F1"}`))),
+			StatusCode: http.StatusOK,
+		}, nil)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+			StatusCode: http.StatusInternalServerError,
+		}, nil)
+
+		c := slack.NewClient(
+			"test_token",
+			slack.WithBaseUrl("http://test.slack.com/api"),
+			slack.WithHttpClient(httpClient),
+		)
+
+		files, err := c.UploadFile(
+			context.Background(),
+			ioutil.NopCloser(strings.NewReader("hello")),
+			slack.FileName("a.txt"),
+			slack.FileSize(5),
+		)
+		assert.Error(t, err)
+		assert.Equal(t, "slack respond with 500 status code on file upload", err.Error())
+		assert.Nil(t, files)
+	})
+
+	t.Run("partial upload", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", methodIs("files.getUploadURLExternal")).Return(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewReader([]byte(
+				`{"ok":true,"upload_url":"http://files.slack.com/upload/a","file_id":"F1"}`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+		httpClient.On("Do", methodIs("files.getUploadURLExternal")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":false,"error":"invalid_arguments"}`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+		httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+			return req.URL.Host == "files.slack.com"
+		})).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("OK"))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+		httpClient.On("Do", methodIs("files.completeUploadExternal")).Run(func(args mock.Arguments) {
+			req := args.Get(0).(*http.Request)
+
+			request, err := ioutil.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"files":[{"id":"F1"}]}`, string(request))
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":true,"files":[{"id":"F1"}]}`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		c := slack.NewClient(
+			"test_token",
+			slack.WithBaseUrl("http://test.slack.com/api"),
+			slack.WithHttpClient(httpClient),
+		)
+
+		second := slack.FileUpload{Reader: strings.NewReader("package main"), Name: "main.go"}
+		files, err := c.UploadFile(
+			context.Background(),
+			strings.NewReader("hello"),
+			slack.FileName("a.txt"),
+			slack.AddFile(second),
+			slack.ShareToChannel("C1"),
+		)
+		assert.Equal(t, []slack.File{{ID: "F1"}}, files)
+
+		var partialErr *slack.PartialUploadError
+		assert.True(t, errors.As(err, &partialErr))
+		assert.Equal(t, []slack.File{{ID: "F1"}}, partialErr.Files)
+		assert.Equal(t, []slack.FileUpload{second}, partialErr.Failed)
+		assert.Equal(t, "can't upload 1 of 2 files: slack respond with error: invalid_arguments", err.Error())
+		httpClient.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,258 @@
+// Package interactions - interactivity handler
+package interactions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/kryabinin/go-slack/signature"
+)
+
+type (
+	// BlockActionHandler handles an interaction with a block element
+	BlockActionHandler func(ctx context.Context, payload *BlockActions, action Action) error
+
+	// ViewSubmissionHandler handles a modal submission. Nil response closes the modal
+	ViewSubmissionHandler func(ctx context.Context, payload *ViewSubmission) (*ViewResponse, error)
+
+	// ViewClosedHandler handles a closed modal
+	ViewClosedHandler func(ctx context.Context, payload *ViewClosed) error
+
+	// GlobalShortcutHandler handles a global shortcut
+	GlobalShortcutHandler func(ctx context.Context, payload *GlobalShortcut) error
+
+	// MessageShortcutHandler handles a message shortcut
+	MessageShortcutHandler func(ctx context.Context, payload *MessageShortcut) error
+
+	// BlockSuggestionHandler returns options of an external select
+	BlockSuggestionHandler func(ctx context.Context, payload *BlockSuggestion) (*OptionsResponse, error)
+
+	// Handler http handler of the interactivity request url. Routes block actions and suggestions by action_id,
+	// views and shortcuts by callback_id. Payloads without handlers are acknowledged with an empty response
+	Handler struct {
+		verifier *signature.Verifier
+		maxAge   time.Duration
+		onError  func(ctx context.Context, payload interface{}, err error)
+
+		mu               sync.RWMutex
+		blockActions     map[string]BlockActionHandler
+		viewSubmissions  map[string]ViewSubmissionHandler
+		viewsClosed      map[string]ViewClosedHandler
+		globalShortcuts  map[string]GlobalShortcutHandler
+		messageShortcuts map[string]MessageShortcutHandler
+		blockSuggestions map[string]BlockSuggestionHandler
+	}
+)
+
+// NewHandler is interactivity handler constructor
+func NewHandler(signingSecret string, opts ...Option) *Handler {
+	h := &Handler{
+		onError:          func(context.Context, interface{}, error) {},
+		blockActions:     make(map[string]BlockActionHandler),
+		viewSubmissions:  make(map[string]ViewSubmissionHandler),
+		viewsClosed:      make(map[string]ViewClosedHandler),
+		globalShortcuts:  make(map[string]GlobalShortcutHandler),
+		messageShortcuts: make(map[string]MessageShortcutHandler),
+		blockSuggestions: make(map[string]BlockSuggestionHandler),
+	}
+
+	for _, opt := range opts {
+		opt.apply(h)
+	}
+
+	h.verifier = signature.NewVerifier(signingSecret, h.maxAge)
+
+	return h
+}
+
+// OnBlockAction registers handler of interactions with elements with the action_id
+func (h *Handler) OnBlockAction(actionID string, handler BlockActionHandler) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.blockActions[actionID] = handler
+}
+
+// OnViewSubmission registers handler of submissions of modals with the callback_id
+func (h *Handler) OnViewSubmission(callbackID string, handler ViewSubmissionHandler) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.viewSubmissions[callbackID] = handler
+}
+
+// OnViewClosed registers handler of closed modals with the callback_id
+func (h *Handler) OnViewClosed(callbackID string, handler ViewClosedHandler) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.viewsClosed[callbackID] = handler
+}
+
+// OnGlobalShortcut registers handler of the global shortcut with the callback_id
+func (h *Handler) OnGlobalShortcut(callbackID string, handler GlobalShortcutHandler) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.globalShortcuts[callbackID] = handler
+}
+
+// OnMessageShortcut registers handler of the message shortcut with the callback_id
+func (h *Handler) OnMessageShortcut(callbackID string, handler MessageShortcutHandler) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.messageShortcuts[callbackID] = handler
+}
+
+// OnBlockSuggestion registers handler of option requests of the external select with the action_id
+func (h *Handler) OnBlockSuggestion(actionID string, handler BlockSuggestionHandler) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.blockSuggestions[actionID] = handler
+}
+
+// ServeHTTP implementation
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := h.verifier.VerifyRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		http.Error(w, "can't parse request", http.StatusBadRequest)
+		return
+	}
+
+	payload, err := ParsePayload([]byte(form.Get("payload")))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.dispatch(r.Context(), payload)
+	if err != nil {
+		h.onError(r.Context(), payload, err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	if resp == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// HandlePayload decodes and routes a JSON payload, e.g. received over Socket Mode, and returns the response to
+// acknowledge it with
+func (h *Handler) HandlePayload(ctx context.Context, data []byte) (interface{}, error) {
+	payload, err := ParsePayload(data)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := h.dispatch(ctx, payload)
+	if err != nil {
+		h.onError(ctx, payload, err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (h *Handler) dispatch(ctx context.Context, payload interface{}) (resp interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			resp, err = nil, fmt.Errorf("interaction handler panic: %v", r)
+		}
+	}()
+
+	// handlers are looked up under the lock and called without it, so they can register other handlers
+	switch p := payload.(type) {
+	case *BlockActions:
+		handlers := make([]BlockActionHandler, len(p.Actions))
+		h.mu.RLock()
+		for i, action := range p.Actions {
+			handlers[i] = h.blockActions[action.ActionID]
+		}
+		h.mu.RUnlock()
+
+		for i, action := range p.Actions {
+			if handlers[i] == nil {
+				continue
+			}
+
+			if err = handlers[i](ctx, p, action); err != nil {
+				return nil, err
+			}
+		}
+	case *ViewSubmission:
+		h.mu.RLock()
+		handler, ok := h.viewSubmissions[p.View.CallbackID]
+		h.mu.RUnlock()
+
+		if ok {
+			viewResp, err := handler(ctx, p)
+			if err != nil || viewResp == nil {
+				return nil, err
+			}
+
+			return viewResp, nil
+		}
+	case *ViewClosed:
+		h.mu.RLock()
+		handler, ok := h.viewsClosed[p.View.CallbackID]
+		h.mu.RUnlock()
+
+		if ok {
+			return nil, handler(ctx, p)
+		}
+	case *GlobalShortcut:
+		h.mu.RLock()
+		handler, ok := h.globalShortcuts[p.CallbackID]
+		h.mu.RUnlock()
+
+		if ok {
+			return nil, handler(ctx, p)
+		}
+	case *MessageShortcut:
+		h.mu.RLock()
+		handler, ok := h.messageShortcuts[p.CallbackID]
+		h.mu.RUnlock()
+
+		if ok {
+			return nil, handler(ctx, p)
+		}
+	case *BlockSuggestion:
+		h.mu.RLock()
+		handler, ok := h.blockSuggestions[p.ActionID]
+		h.mu.RUnlock()
+
+		if ok {
+			optionsResp, err := handler(ctx, p)
+			if err != nil || optionsResp == nil {
+				return nil, err
+			}
+
+			return optionsResp, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,194 @@
+package interactions_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kryabinin/go-slack"
+	"github.com/kryabinin/go-slack/interactions"
+	"github.com/kryabinin/go-slack/signature"
+)
+
+const signingSecret = "test_secret"
+
+func signedRequest(payload string) *http.Request {
+	now := time.Now().Unix()
+	body := url.Values{"payload": {payload}}.Encode()
+
+	req := httptest.NewRequest(http.MethodPost, "/slack/interactions", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set(signature.HeaderTimestamp, strconv.FormatInt(now, 10))
+	req.Header.Set(signature.HeaderSignature, signature.Sign(signingSecret, now, []byte(body)))
+
+	return req
+}
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	t.Run("block actions", func(t *testing.T) {
+		var handled []string
+
+		h := interactions.NewHandler(signingSecret)
+		h.OnBlockAction("approve", func(ctx context.Context, p *interactions.BlockActions, a interactions.Action) error {
+			assert.Equal(t, "T1", p.Team.ID)
+			assert.Equal(t, "U1", p.User.ID)
+			assert.Equal(t, "1.2", p.Message.Timestamp)
+			assert.Equal(t, "https://hooks.slack.com/actions/1", p.ResponseURL)
+
+			handled = append(handled, a.Value)
+			return nil
+		})
+		h.OnBlockAction("priority", func(ctx context.Context, p *interactions.BlockActions, a interactions.Action) error {
+			handled = append(handled, a.SelectedOption.Value)
+			return nil
+		})
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, signedRequest(`{"type":"block_actions","team":{"id":"T1"},"user":{"id":"U1"},`+
+			`"container":{"type":"message","message_ts":"1.2","channel_id":"C1"},"channel":{"id":"C1"},`+
+			`"message":{"type":"message","ts":"1.2","text":"approve?"},`+
+			`"response_url":"https://hooks.slack.com/actions/1","actions":[`+
+			`{"action_id":"approve","block_id":"b1","type":"button","value":"yes"},`+
+			`{"action_id":"priority","block_id":"b2","type":"static_select",`+
+			`"selected_option":{"text":{"type":"plain_text","text":"High"},"value":"high"}},`+
+			`{"action_id":"unknown","block_id":"b3","type":"button"}]}`))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Body.String())
+		assert.Equal(t, []string{"yes", "high"}, handled)
+	})
+
+	t.Run("view submission with errors", func(t *testing.T) {
+		h := interactions.NewHandler(signingSecret)
+		h.OnViewSubmission("feedback", func(ctx context.Context, p *interactions.ViewSubmission) (*interactions.ViewResponse, error) {
+			assert.Equal(t, "V1", p.View.ID)
+			assert.Equal(t, "meta", p.View.PrivateMetadata)
+
+			return interactions.ResponseErrors(map[string]string{"email": "invalid email"}), nil
+		})
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, signedRequest(`{"type":"view_submission","team":{"id":"T1"},"user":{"id":"U1"},`+
+			`"view":{"id":"V1","type":"modal","callback_id":"feedback","private_metadata":"meta",`+
+			`"state":{"values":{"email":{"email_input":{"type":"plain_text_input","value":"x"}}}}}}`))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"response_action":"errors","errors":{"email":"invalid email"}}`, rec.Body.String())
+	})
+
+	t.Run("view submission pushing view", func(t *testing.T) {
+		h := interactions.NewHandler(signingSecret)
+		h.OnViewSubmission("wizard", func(ctx context.Context, p *interactions.ViewSubmission) (*interactions.ViewResponse, error) {
+			return interactions.ResponsePush(slack.View{Type: slack.ViewModal, Title: slack.PlainText("Step 2")}), nil
+		})
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, signedRequest(`{"type":"view_submission","view":{"id":"V1","callback_id":"wizard"}}`))
+
+		assert.JSONEq(t, `{"response_action":"push","view":{"type":"modal",`+
+			`"title":{"type":"plain_text","text":"Step 2"},"blocks":[]}}`, rec.Body.String())
+	})
+
+	t.Run("block suggestion", func(t *testing.T) {
+		h := interactions.NewHandler(signingSecret)
+		h.OnBlockSuggestion("city", func(ctx context.Context, p *interactions.BlockSuggestion) (*interactions.OptionsResponse, error) {
+			assert.Equal(t, "Lon", p.Value)
+
+			return &interactions.OptionsResponse{Options: []slack.Option{
+				{Text: slack.PlainText("London"), Value: "london"},
+			}}, nil
+		})
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, signedRequest(`{"type":"block_suggestion","action_id":"city","block_id":"b1","value":"Lon"}`))
+
+		assert.JSONEq(t, `{"options":[{"text":{"type":"plain_text","text":"London"},"value":"london"}]}`,
+			rec.Body.String())
+	})
+
+	t.Run("shortcut error", func(t *testing.T) {
+		expErr := errors.New("test error")
+
+		var handled error
+		h := interactions.NewHandler(signingSecret, interactions.WithErrorHandler(
+			func(ctx context.Context, payload interface{}, err error) {
+				assert.IsType(t, &interactions.GlobalShortcut{}, payload)
+				handled = err
+			},
+		))
+		h.OnGlobalShortcut("new_ticket", func(ctx context.Context, p *interactions.GlobalShortcut) error {
+			return expErr
+		})
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, signedRequest(`{"type":"shortcut","callback_id":"new_ticket","trigger_id":"t1"}`))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Equal(t, expErr, handled)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		h := interactions.NewHandler("other_secret")
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, signedRequest(`{"type":"shortcut"}`))
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+
+	t.Run("unknown payload type", func(t *testing.T) {
+		h := interactions.NewHandler(signingSecret)
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, signedRequest(`{"type":"interactive_message"}`))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestHandler_HandlePayload(t *testing.T) {
+	h := interactions.NewHandler(signingSecret)
+	h.OnMessageShortcut("quote", func(ctx context.Context, p *interactions.MessageShortcut) error {
+		assert.Equal(t, "C1", p.Channel.ID)
+		assert.Equal(t, "hello", p.Message.Text)
+
+		return nil
+	})
+
+	resp, err := h.HandlePayload(context.Background(), []byte(`{"type":"message_action","callback_id":"quote",`+
+		`"channel":{"id":"C1"},"message":{"type":"message","text":"hello","ts":"1.2"},"message_ts":"1.2"}`))
+	assert.NoError(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestHandler_RegisterFromHandler(t *testing.T) {
+	h := interactions.NewHandler(signingSecret)
+	h.OnGlobalShortcut("open", func(ctx context.Context, p *interactions.GlobalShortcut) error {
+		h.OnViewClosed("modal", func(ctx context.Context, p *interactions.ViewClosed) error {
+			return nil
+		})
+
+		return nil
+	})
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := h.HandlePayload(context.Background(), []byte(`{"type":"shortcut","callback_id":"open"}`))
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("handler registration from a handler is blocked")
+	}
+}
@@ -0,0 +1,303 @@
+// Package interactions - interactivity payloads
+package interactions
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/kryabinin/go-slack"
+)
+
+const (
+	// TypeBlockActions type of payloads of interactions with block elements
+	TypeBlockActions = "block_actions"
+
+	// TypeViewSubmission type of payloads of modal submissions
+	TypeViewSubmission = "view_submission"
+
+	// TypeViewClosed type of payloads of closed modals
+	TypeViewClosed = "view_closed"
+
+	// TypeShortcut type of payloads of global shortcuts
+	TypeShortcut = "shortcut"
+
+	// TypeMessageAction type of payloads of message shortcuts
+	TypeMessageAction = "message_action"
+
+	// TypeBlockSuggestion type of payloads requesting options of external selects
+	TypeBlockSuggestion = "block_suggestion"
+)
+
+type (
+	// Payload fields common for all interactivity payloads
+	Payload struct {
+		// Type type of the payload
+		Type string `json:"type"`
+
+		// Team workspace of the interaction
+		Team Team `json:"team"`
+
+		// User user interacted with the app
+		User User `json:"user"`
+
+		// Enterprise Enterprise Grid organization of the interaction, nil outside of Enterprise Grid
+		Enterprise *Enterprise `json:"enterprise"`
+
+		// IsEnterpriseInstall true if the app is installed to the whole organization
+		IsEnterpriseInstall bool `json:"is_enterprise_install"`
+
+		// APIAppID app the payload is intended for
+		APIAppID string `json:"api_app_id"`
+
+		// Token deprecated verification token, use signing secret instead
+		Token string `json:"token"`
+
+		// TriggerID short-lived id to open a modal
+		TriggerID string `json:"trigger_id"`
+	}
+
+	// Team workspace of an interaction
+	Team struct {
+		// ID workspace identifier
+		ID string `json:"id"`
+
+		// Domain workspace domain
+		Domain string `json:"domain"`
+
+		// EnterpriseID Enterprise Grid organization of the workspace
+		EnterpriseID string `json:"enterprise_id"`
+	}
+
+	// Enterprise Enterprise Grid organization of an interaction
+	Enterprise struct {
+		// ID organization identifier
+		ID string `json:"id"`
+
+		// Name organization name
+		Name string `json:"name"`
+	}
+
+	// User user interacted with the app
+	User struct {
+		// ID user identifier
+		ID string `json:"id"`
+
+		// Username user name
+		Username string `json:"username"`
+
+		// TeamID workspace of the user
+		TeamID string `json:"team_id"`
+	}
+
+	// Channel channel of an interaction
+	Channel struct {
+		// ID channel identifier
+		ID string `json:"id"`
+
+		// Name channel name
+		Name string `json:"name"`
+	}
+
+	// Container container of the interacted element: a message or a view
+	Container struct {
+		// Type message or view
+		Type string `json:"type"`
+
+		// MessageTs ts value of the message
+		MessageTs string `json:"message_ts"`
+
+		// ChannelID channel of the message
+		ChannelID string `json:"channel_id"`
+
+		// IsEphemeral true if the message is ephemeral
+		IsEphemeral bool `json:"is_ephemeral"`
+
+		// ViewID id of the view
+		ViewID string `json:"view_id"`
+	}
+
+	// Message message an interaction originated from
+	Message struct {
+		// Type always message
+		Type string `json:"type"`
+
+		// User author of the message
+		User string `json:"user"`
+
+		// BotID id of bot if message was posted by a bot
+		BotID string `json:"bot_id"`
+
+		// Text message text
+		Text string `json:"text"`
+
+		// Timestamp ts value of the message
+		Timestamp string `json:"ts"`
+
+		// ThreadTimestamp ts value of the parent message
+		ThreadTimestamp string `json:"thread_ts"`
+	}
+
+	// Action interaction with a block element
+	Action struct {
+		// ActionID identifier of the element
+		ActionID string `json:"action_id"`
+
+		// BlockID identifier of the block containing the element
+		BlockID string `json:"block_id"`
+
+		// ActionTs time of the action
+		ActionTs string `json:"action_ts"`
+
+		// Text text of a button
+		Text *slack.TextObject `json:"text"`
+
+		slack.StateValue
+	}
+
+	// BlockActions payload of interactions with block elements of messages and views
+	BlockActions struct {
+		Payload
+
+		// Container container of the interacted elements
+		Container Container `json:"container"`
+
+		// Channel channel of the message, nil for views
+		Channel *Channel `json:"channel"`
+
+		// Message message containing the elements, nil for views
+		Message *Message `json:"message"`
+
+		// View view containing the elements, nil for messages
+		View *slack.View `json:"view"`
+
+		// ResponseURL url to respond to the message, empty for views
+		ResponseURL string `json:"response_url"`
+
+		// Actions interactions with the elements, usually one
+		Actions []Action `json:"actions"`
+	}
+
+	// ResponseURL response url of a modal submission
+	ResponseURL struct {
+		// BlockID block containing the conversation select
+		BlockID string `json:"block_id"`
+
+		// ActionID conversation select the url is generated for
+		ActionID string `json:"action_id"`
+
+		// ChannelID selected channel
+		ChannelID string `json:"channel_id"`
+
+		// ResponseURL url to post a message to the channel
+		ResponseURL string `json:"response_url"`
+	}
+
+	// ViewSubmission payload of a modal submission
+	ViewSubmission struct {
+		Payload
+
+		// View submitted view with state of the inputs
+		View slack.View `json:"view"`
+
+		// ResponseURLs response urls of conversation selects with response_url_enabled
+		ResponseURLs []ResponseURL `json:"response_urls"`
+	}
+
+	// ViewClosed payload of a closed modal, sent only for views with notify_on_close
+	ViewClosed struct {
+		Payload
+
+		// View closed view
+		View slack.View `json:"view"`
+
+		// IsCleared true if the whole view stack was closed
+		IsCleared bool `json:"is_cleared"`
+	}
+
+	// GlobalShortcut payload of a global shortcut
+	GlobalShortcut struct {
+		Payload
+
+		// CallbackID identifier of the shortcut
+		CallbackID string `json:"callback_id"`
+
+		// ActionTs time of the shortcut
+		ActionTs string `json:"action_ts"`
+	}
+
+	// MessageShortcut payload of a message shortcut
+	MessageShortcut struct {
+		Payload
+
+		// CallbackID identifier of the shortcut
+		CallbackID string `json:"callback_id"`
+
+		// ActionTs time of the shortcut
+		ActionTs string `json:"action_ts"`
+
+		// Channel channel of the message
+		Channel Channel `json:"channel"`
+
+		// Message message the shortcut was invoked on
+		Message Message `json:"message"`
+
+		// MessageTs ts value of the message
+		MessageTs string `json:"message_ts"`
+
+		// ResponseURL url to respond to the message
+		ResponseURL string `json:"response_url"`
+	}
+
+	// BlockSuggestion payload requesting options of an external select
+	BlockSuggestion struct {
+		Payload
+
+		// ActionID identifier of the select
+		ActionID string `json:"action_id"`
+
+		// BlockID identifier of the block containing the select
+		BlockID string `json:"block_id"`
+
+		// Value text typed by the user
+		Value string `json:"value"`
+
+		// Container container of the select
+		Container Container `json:"container"`
+
+		// View view containing the select, nil for messages
+		View *slack.View `json:"view"`
+	}
+)
+
+// ParsePayload decodes an interactivity payload into *BlockActions, *ViewSubmission, *ViewClosed, *GlobalShortcut,
+// *MessageShortcut or *BlockSuggestion by its type
+func ParsePayload(data []byte) (interface{}, error) {
+	var payload Payload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, fmt.Errorf("can't unmarshal payload: %w", err)
+	}
+
+	var typed interface{}
+	switch payload.Type {
+	case TypeBlockActions:
+		typed = &BlockActions{}
+	case TypeViewSubmission:
+		typed = &ViewSubmission{}
+	case TypeViewClosed:
+		typed = &ViewClosed{}
+	case TypeShortcut:
+		typed = &GlobalShortcut{}
+	case TypeMessageAction:
+		typed = &MessageShortcut{}
+	case TypeBlockSuggestion:
+		typed = &BlockSuggestion{}
+	default:
+		return nil, fmt.Errorf("unknown payload type %q", payload.Type)
+	}
+
+	if err := json.Unmarshal(data, typed); err != nil {
+		return nil, fmt.Errorf("can't unmarshal %s payload: %w", payload.Type, err)
+	}
+
+	return typed, nil
+}
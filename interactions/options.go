@@ -0,0 +1,40 @@
+// Package interactions - handler options
+package interactions
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// Option to use optional parameters in interactivity handler
+	Option interface {
+		apply(h *Handler)
+	}
+
+	withMaxAge struct {
+		maxAge time.Duration
+	}
+
+	withErrorHandler struct {
+		handler func(ctx context.Context, payload interface{}, err error)
+	}
+)
+
+// WithMaxAge sets maximum age of requests, 5 minutes by default
+func WithMaxAge(maxAge time.Duration) Option {
+	return &withMaxAge{maxAge: maxAge}
+}
+
+func (opt *withMaxAge) apply(h *Handler) {
+	h.maxAge = opt.maxAge
+}
+
+// WithErrorHandler sets handler of errors returned by interaction handlers
+func WithErrorHandler(handler func(ctx context.Context, payload interface{}, err error)) Option {
+	return &withErrorHandler{handler: handler}
+}
+
+func (opt *withErrorHandler) apply(h *Handler) {
+	h.onError = opt.handler
+}
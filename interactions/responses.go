@@ -0,0 +1,61 @@
+// Package interactions - responses
+package interactions
+
+import "github.com/kryabinin/go-slack"
+
+const (
+	// ResponseActionErrors show validation errors of input blocks
+	ResponseActionErrors = "errors"
+
+	// ResponseActionUpdate update the submitted view
+	ResponseActionUpdate = "update"
+
+	// ResponseActionPush push a new view to the stack
+	ResponseActionPush = "push"
+
+	// ResponseActionClear close all views of the stack
+	ResponseActionClear = "clear"
+)
+
+type (
+	// ViewResponse response to a modal submission
+	ViewResponse struct {
+		// ResponseAction one of ResponseAction constants
+		ResponseAction string `json:"response_action"`
+
+		// Errors error messages by block_id of input blocks, only for ResponseActionErrors
+		Errors map[string]string `json:"errors,omitempty"`
+
+		// View view to update with or to push, only for ResponseActionUpdate and ResponseActionPush
+		View *slack.View `json:"view,omitempty"`
+	}
+
+	// OptionsResponse options of an external select
+	OptionsResponse struct {
+		// Options list of options
+		Options []slack.Option `json:"options,omitempty"`
+
+		// OptionGroups list of option groups, used instead of options
+		OptionGroups []slack.OptionGroup `json:"option_groups,omitempty"`
+	}
+)
+
+// ResponseErrors shows validation errors by block_id of input blocks
+func ResponseErrors(errs map[string]string) *ViewResponse {
+	return &ViewResponse{ResponseAction: ResponseActionErrors, Errors: errs}
+}
+
+// ResponseUpdate updates the submitted view
+func ResponseUpdate(view slack.View) *ViewResponse {
+	return &ViewResponse{ResponseAction: ResponseActionUpdate, View: &view}
+}
+
+// ResponsePush pushes a new view to the stack
+func ResponsePush(view slack.View) *ViewResponse {
+	return &ViewResponse{ResponseAction: ResponseActionPush, View: &view}
+}
+
+// ResponseClear closes all views of the stack
+func ResponseClear() *ViewResponse {
+	return &ViewResponse{ResponseAction: ResponseActionClear}
+}
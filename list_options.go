@@ -0,0 +1,85 @@
+// Package slack - list options
+package slack
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type (
+	// ListOption to apply optional parameters to paginated list requests
+	ListOption interface {
+		apply(params url.Values)
+	}
+
+	// ResponseMetadata contains pagination information of list responses
+	ResponseMetadata struct {
+		// NextCursor cursor to pass to the next request to get the next page. Empty when there are no more pages
+		NextCursor string `json:"next_cursor"`
+	}
+
+	listParam struct {
+		key string
+		val string
+	}
+)
+
+// Cursor sets a cursor returned in the previous page's response metadata
+func Cursor(cursor string) ListOption {
+	return &listParam{key: "cursor", val: cursor}
+}
+
+// Limit sets the maximum number of items to return
+func Limit(limit int) ListOption {
+	return &listParam{key: "limit", val: strconv.Itoa(limit)}
+}
+
+// ByUser filters items by user id
+func ByUser(userID string) ListOption {
+	return &listParam{key: "user", val: userID}
+}
+
+// ByChannel filters items by channel id
+func ByChannel(channelID string) ListOption {
+	return &listParam{key: "channel", val: channelID}
+}
+
+// ByTypes filters files by types, e.g. images, snippets or pdfs
+func ByTypes(types ...string) ListOption {
+	return &listParam{key: "types", val: strings.Join(types, ",")}
+}
+
+// From filters items created after the time
+func From(from time.Time) ListOption {
+	return &listParam{key: "ts_from", val: strconv.FormatInt(from.Unix(), 10)}
+}
+
+// To filters items created before the time
+func To(to time.Time) ListOption {
+	return &listParam{key: "ts_to", val: strconv.FormatInt(to.Unix(), 10)}
+}
+
+// Page sets page number for page based pagination
+func Page(page int) ListOption {
+	return &listParam{key: "page", val: strconv.Itoa(page)}
+}
+
+// Count sets number of items per page for page based pagination
+func Count(count int) ListOption {
+	return &listParam{key: "count", val: strconv.Itoa(count)}
+}
+
+func (opt *listParam) apply(params url.Values) {
+	params.Set(opt.key, opt.val)
+}
+
+func listParams(opts []ListOption) url.Values {
+	params := url.Values{}
+	for _, opt := range opts {
+		opt.apply(params)
+	}
+
+	return params
+}
@@ -0,0 +1,232 @@
+// Package slack - logging
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"time"
+)
+
+// Log levels
+const (
+	LogLevelDebug LogLevel = iota
+	LogLevelInfo
+	LogLevelWarn
+	LogLevelError
+)
+
+// Redacted replacement of redacted values in logs and recordings
+const Redacted = "REDACTED"
+
+var (
+	// secretFields fields of request and response bodies redacted regardless of the policy
+	secretFields = map[string]bool{
+		"client_secret": true,
+		"refresh_token": true,
+		"access_token":  true,
+		"code":          true,
+		"token":         true,
+	}
+
+	// textFields fields of message text redacted by the policy
+	textFields = map[string]bool{"text": true, "fallback": true, "pretext": true}
+
+	// TokenPattern matches slack tokens of any type
+	TokenPattern = regexp.MustCompile(`(xox[abposre]|xapp|xoxe\.xox[bp])-[0-9A-Za-z-]+`)
+
+	// EmailPattern matches email addresses
+	EmailPattern = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+
+	// DefaultRedactionPolicy redacts everything sensitive
+	DefaultRedactionPolicy = RedactionPolicy{Tokens: true, Authorization: true, Emails: true, MessageText: true}
+)
+
+type (
+	// LogLevel level of log records
+	LogLevel int
+
+	// LogField key value pair of a log record
+	LogField struct {
+		Key   string
+		Value interface{}
+	}
+
+	// Logger small logging interface to adapt log/slog, zap, logrus or any other logger to
+	Logger interface {
+		// Log writes a log record
+		Log(ctx context.Context, level LogLevel, msg string, fields ...LogField)
+	}
+
+	// RedactionPolicy what to redact in logged requests and responses
+	RedactionPolicy struct {
+		// Tokens redact slack tokens
+		Tokens bool
+
+		// Authorization redact Authorization header
+		Authorization bool
+
+		// Emails redact email addresses
+		Emails bool
+
+		// MessageText redact text fields of json bodies
+		MessageText bool
+	}
+
+	logging struct {
+		logger       Logger
+		successLevel LogLevel
+		failureLevel LogLevel
+		bodies       bool
+		policy       RedactionPolicy
+	}
+)
+
+// String returns name of the level
+func (l LogLevel) String() string {
+	switch l {
+	case LogLevelDebug:
+		return "debug"
+	case LogLevelInfo:
+		return "info"
+	case LogLevelWarn:
+		return "warn"
+	case LogLevelError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
+func (l *logging) middleware(next RoundTrip) RoundTrip {
+	return func(ctx context.Context, req *Request) (*http.Response, error) {
+		fields := []LogField{
+			{Key: "method", Value: req.Method},
+			{Key: "attempt", Value: req.Attempt},
+		}
+
+		if len(req.TokenIdentity) > 0 {
+			fields = append(fields, LogField{Key: "token", Value: req.TokenIdentity})
+		}
+
+		if l.bodies {
+			fields = append(fields,
+				LogField{Key: "request_header", Value: l.header(req.HTTPRequest.Header)},
+				LogField{Key: "request_body", Value: l.redact(req.Body, req.HTTPRequest.Header.Get("Content-Type"))},
+			)
+		}
+
+		start := time.Now()
+		resp, err := next(ctx, req)
+		fields = append(fields, LogField{Key: "duration", Value: time.Since(start)})
+
+		if err != nil {
+			l.logger.Log(ctx, l.failureLevel, "slack api call failed", append(fields, LogField{Key: "error", Value: err})...)
+			return resp, err
+		}
+
+		fields = append(fields, LogField{Key: "status", Value: resp.StatusCode})
+
+		body, readErr := PeekJSONBody(resp)
+		if readErr != nil {
+			fields = append(fields, LogField{Key: "error", Value: readErr})
+		}
+
+		if l.bodies && len(body) > 0 {
+			fields = append(fields, LogField{Key: "response_body", Value: l.redact(body, contentTypeJSON)})
+		}
+
+		level := l.successLevel
+		if code := errorCode(body); len(code) > 0 || resp.StatusCode != http.StatusOK || readErr != nil {
+			level = l.failureLevel
+			fields = append(fields, LogField{Key: "slack_error", Value: code})
+		}
+
+		l.logger.Log(ctx, level, "slack api call", fields...)
+
+		return resp, nil
+	}
+}
+
+func (l *logging) header(header http.Header) http.Header {
+	logged := header.Clone()
+	if l.policy.Authorization && len(logged.Get("Authorization")) > 0 {
+		logged.Set("Authorization", Redacted)
+	}
+
+	return logged
+}
+
+// redact redacts json and form bodies, secrets are always redacted, other data by the policy
+func (l *logging) redact(body []byte, contentType string) string {
+	redactedBody := string(body)
+
+	if contentType == contentTypeForm {
+		if form, err := url.ParseQuery(redactedBody); err == nil {
+			redactedBody = l.redactForm(form).Encode()
+		}
+	} else {
+		var data interface{}
+		if json.Unmarshal(body, &data) == nil {
+			if text, err := json.Marshal(l.redactJSON(data)); err == nil {
+				redactedBody = string(text)
+			}
+		}
+	}
+
+	if l.policy.Tokens {
+		redactedBody = TokenPattern.ReplaceAllString(redactedBody, Redacted)
+	}
+
+	if l.policy.Emails {
+		redactedBody = EmailPattern.ReplaceAllString(redactedBody, Redacted)
+	}
+
+	return redactedBody
+}
+
+// redactForm redacts form fields, json values of fields like blocks are redacted as json
+func (l *logging) redactForm(form url.Values) url.Values {
+	for key, values := range form {
+		for i, val := range values {
+			var data interface{}
+			switch {
+			case l.redactedField(key):
+				values[i] = Redacted
+			case json.Unmarshal([]byte(val), &data) == nil:
+				if text, err := json.Marshal(l.redactJSON(data)); err == nil {
+					values[i] = string(text)
+				}
+			}
+		}
+	}
+
+	return form
+}
+
+// redactJSON redacts string fields of json data
+func (l *logging) redactJSON(data interface{}) interface{} {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if _, ok := val.(string); ok && l.redactedField(key) {
+				v[key] = Redacted
+				continue
+			}
+
+			v[key] = l.redactJSON(val)
+		}
+	case []interface{}:
+		for i, val := range v {
+			v[i] = l.redactJSON(val)
+		}
+	}
+
+	return data
+}
+
+func (l *logging) redactedField(key string) bool {
+	return secretFields[key] || (l.policy.MessageText && textFields[key])
+}
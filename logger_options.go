@@ -0,0 +1,38 @@
+// Package slack - logger options
+package slack
+
+type (
+	// LogOption to use optional parameters in logging of the client
+	LogOption interface {
+		apply(l *logging)
+	}
+
+	logLevels struct {
+		success LogLevel
+		failure LogLevel
+	}
+
+	logBodies struct {
+		policy RedactionPolicy
+	}
+)
+
+// LogLevels sets levels of successful and failed calls, debug and warn by default
+func LogLevels(success, failure LogLevel) LogOption {
+	return &logLevels{success: success, failure: failure}
+}
+
+func (opt *logLevels) apply(l *logging) {
+	l.successLevel = opt.success
+	l.failureLevel = opt.failure
+}
+
+// LogBodies enables logging of request headers and bodies of requests and responses redacted by the policy
+func LogBodies(policy RedactionPolicy) LogOption {
+	return &logBodies{policy: policy}
+}
+
+func (opt *logBodies) apply(l *logging) {
+	l.bodies = true
+	l.policy = opt.policy
+}
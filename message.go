@@ -10,8 +10,9 @@ import (
 type (
 	// Message entity
 	Message struct {
-		// Channel channel, private group, or IM channel to send Message to. Can be an encoded ID, or a name
-		Channel string `json:"channel"`
+		// Channel channel, private group, or IM channel to send Message to. Can be an encoded ID, or a name. Omitted
+		// in webhook messages, their channel is defined by the url
+		Channel string `json:"channel,omitempty"`
 
 		// Text main body text of the Message. If blocks field is passed, text is used as a fallback string to display
 		// in notifications
@@ -118,7 +119,7 @@ type MessagePosted struct {
 	Channel string `json:"channel"`
 
 	// Timestamp can be used in other Message to reply
-	Timestamp string `json:"timestamp"`
+	Timestamp string `json:"ts"`
 
 	// Message Message body
 	Message struct {
@@ -131,6 +132,18 @@ type MessagePosted struct {
 		// BotID id of bot
 		BotID string `json:"bot_id"`
 
+		// Team workspace of the author
+		Team string `json:"team"`
+
+		// EnterpriseID Enterprise Grid organization of the author, empty outside of Enterprise Grid
+		EnterpriseID string `json:"enterprise_id"`
+
+		// UserTeam workspace of the author's account, differs from Team for shared channels
+		UserTeam string `json:"user_team"`
+
+		// SourceTeam workspace the message was posted from
+		SourceTeam string `json:"source_team"`
+
 		// Attachments list
 		Attachments []struct {
 			// Text attachment's text
@@ -150,7 +163,7 @@ type MessagePosted struct {
 		SubType string `json:"subtype"`
 
 		// Timestamp can be used in other Message to reply
-		Timestamp string `json:"timestamp"`
+		Timestamp string `json:"ts"`
 	} `json:"Message"`
 }
 
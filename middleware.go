@@ -0,0 +1,138 @@
+// Package slack - middlewares
+package slack
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	// MethodUploadContent pseudo method name of requests uploading file content to the url got from
+	// files.getUploadURLExternal
+	MethodUploadContent = "files.uploadContent"
+
+	// MethodDownloadContent pseudo method name of requests downloading file content
+	MethodDownloadContent = "files.downloadContent"
+)
+
+type (
+	// Request api request passing through middlewares
+	Request struct {
+		// Method slack api method, e.g. chat.postMessage, or one of pseudo methods of file content transfer
+		Method string
+
+		// Params query parameters of the request and fields of form encoded body
+		Params url.Values
+
+		// Body request body, nil for get requests and file content uploads
+		Body []byte
+
+		// TokenType type of the token the request is authorized with
+		TokenType TokenType
+
+		// TokenIdentity masked token safe to log, e.g. xoxb-…9f2c
+		TokenIdentity string
+
+		// Attempt number of the attempt to send the request starting with 1
+		Attempt int
+
+		// RateLimitWait time the request waited for the rate limit before sending
+		RateLimitWait time.Duration
+
+		// HTTPRequest http request to send, middlewares can change it, e.g. add headers
+		HTTPRequest *http.Request
+	}
+
+	// RoundTrip sends a request and returns http response. Response body must be closed by the caller
+	RoundTrip func(ctx context.Context, req *Request) (*http.Response, error)
+
+	// Middleware wraps a round trip with additional behavior, e.g. tracing, metrics or audit logging
+	Middleware func(next RoundTrip) RoundTrip
+)
+
+// chain composes middlewares around the round trip, the first middleware is the outermost
+func chain(middlewares []Middleware, roundTrip RoundTrip) RoundTrip {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		roundTrip = middlewares[i](roundTrip)
+	}
+
+	return roundTrip
+}
+
+// newRequest makes request of the api path with query, e.g. users.lookupByEmail?email=...
+func newRequest(httpReq *http.Request, apiPath, token string, data []byte, attempt int) *Request {
+	method := apiPath
+	params := url.Values{}
+	if i := strings.Index(apiPath, "?"); i >= 0 {
+		method = apiPath[:i]
+		params, _ = url.ParseQuery(apiPath[i+1:])
+	}
+
+	if httpReq.Header.Get("Content-Type") == contentTypeForm {
+		if form, err := url.ParseQuery(string(data)); err == nil {
+			for key, values := range form {
+				params[key] = append(params[key], values...)
+			}
+		}
+	}
+
+	return &Request{
+		Method:        path.Base(method),
+		Params:        params,
+		Body:          data,
+		TokenType:     DetectTokenType(token),
+		TokenIdentity: maskToken(token),
+		Attempt:       attempt,
+		HTTPRequest:   httpReq,
+	}
+}
+
+// maskToken keeps only the prefix and the last 4 characters of the token
+func maskToken(token string) string {
+	if len(token) < 12 {
+		return "…"
+	}
+
+	prefix := ""
+	if i := strings.Index(token, "-"); i >= 0 && i < 10 {
+		prefix = token[:i+1]
+	}
+
+	return prefix + "…" + token[len(token)-4:]
+}
+
+// PeekJSONBody reads body of a json response and restores it for the next reader. Bodies of other content types,
+// e.g. streamed file downloads, are not read and nil is returned. Middlewares can use it to inspect slack errors
+func PeekJSONBody(resp *http.Response) ([]byte, error) {
+	if !strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		return nil, nil
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		resp.Body = ioutil.NopCloser(io.MultiReader(bytes.NewReader(body), failingReader{err: err}))
+		return body, err
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+// failingReader returns the error of reading the original body
+type failingReader struct {
+	err error
+}
+
+func (r failingReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
@@ -3,6 +3,7 @@
 package slack
 
 import context "context"
+import io "io"
 import mock "github.com/stretchr/testify/mock"
 
 // MockClient is an autogenerated mock type for the Client type
@@ -10,6 +11,202 @@ type MockClient struct {
 	mock.Mock
 }
 
+// AddBookmark provides a mock function with given fields: ctx, channel, title, link, opts
+func (_m *MockClient) AddBookmark(ctx context.Context, channel string, title string, link string, opts ...BookmarkOption) (Bookmark, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, channel, title, link)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 Bookmark
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, ...BookmarkOption) Bookmark); ok {
+		r0 = rf(ctx, channel, title, link, opts...)
+	} else {
+		r0 = ret.Get(0).(Bookmark)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, ...BookmarkOption) error); ok {
+		r1 = rf(ctx, channel, title, link, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddPin provides a mock function with given fields: ctx, msg
+func (_m *MockClient) AddPin(ctx context.Context, msg MessageRef) error {
+	ret := _m.Called(ctx, msg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, MessageRef) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddReaction provides a mock function with given fields: ctx, name, msg
+func (_m *MockClient) AddReaction(ctx context.Context, name string, msg MessageRef) error {
+	ret := _m.Called(ctx, name, msg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, MessageRef) error); ok {
+		r0 = rf(ctx, name, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthTest provides a mock function with given fields: ctx
+func (_m *MockClient) AuthTest(ctx context.Context) (AuthInfo, error) {
+	ret := _m.Called(ctx)
+
+	var r0 AuthInfo
+	if rf, ok := ret.Get(0).(func(context.Context) AuthInfo); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(AuthInfo)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteFile provides a mock function with given fields: ctx, fileID
+func (_m *MockClient) DeleteFile(ctx context.Context, fileID string) error {
+	ret := _m.Called(ctx, fileID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, fileID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DownloadFile provides a mock function with given fields: ctx, file, w
+func (_m *MockClient) DownloadFile(ctx context.Context, file File, w io.Writer) error {
+	ret := _m.Called(ctx, file, w)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, File, io.Writer) error); ok {
+		r0 = rf(ctx, file, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EditBookmark provides a mock function with given fields: ctx, channel, bookmarkID, opts
+func (_m *MockClient) EditBookmark(ctx context.Context, channel string, bookmarkID string, opts ...BookmarkOption) (Bookmark, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, channel, bookmarkID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 Bookmark
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, ...BookmarkOption) Bookmark); ok {
+		r0 = rf(ctx, channel, bookmarkID, opts...)
+	} else {
+		r0 = ret.Get(0).(Bookmark)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, ...BookmarkOption) error); ok {
+		r1 = rf(ctx, channel, bookmarkID, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFileInfo provides a mock function with given fields: ctx, fileID
+func (_m *MockClient) GetFileInfo(ctx context.Context, fileID string) (File, error) {
+	ret := _m.Called(ctx, fileID)
+
+	var r0 File
+	if rf, ok := ret.Get(0).(func(context.Context, string) File); ok {
+		r0 = rf(ctx, fileID)
+	} else {
+		r0 = ret.Get(0).(File)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, fileID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReactions provides a mock function with given fields: ctx, item
+func (_m *MockClient) GetReactions(ctx context.Context, item ItemRef) (ReactedItem, error) {
+	ret := _m.Called(ctx, item)
+
+	var r0 ReactedItem
+	if rf, ok := ret.Get(0).(func(context.Context, ItemRef) ReactedItem); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Get(0).(ReactedItem)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ItemRef) error); ok {
+		r1 = rf(ctx, item)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTeamInfo provides a mock function with given fields: ctx, teamID
+func (_m *MockClient) GetTeamInfo(ctx context.Context, teamID string) (Team, error) {
+	ret := _m.Called(ctx, teamID)
+
+	var r0 Team
+	if rf, ok := ret.Get(0).(func(context.Context, string) Team); ok {
+		r0 = rf(ctx, teamID)
+	} else {
+		r0 = ret.Get(0).(Team)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, teamID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetUserByEmail provides a mock function with given fields: ctx, email
 func (_m *MockClient) GetUserByEmail(ctx context.Context, email string) (User, error) {
 	ret := _m.Called(ctx, email)
@@ -31,7 +228,158 @@ func (_m *MockClient) GetUserByEmail(ctx context.Context, email string) (User, e
 	return r0, r1
 }
 
-// PostMessage provides a mock function with given fields: ctx, Message, channel, opts
+// ListAuthTeams provides a mock function with given fields: ctx, opts
+func (_m *MockClient) ListAuthTeams(ctx context.Context, opts ...ListOption) (TeamsList, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 TeamsList
+	if rf, ok := ret.Get(0).(func(context.Context, ...ListOption) TeamsList); ok {
+		r0 = rf(ctx, opts...)
+	} else {
+		r0 = ret.Get(0).(TeamsList)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ...ListOption) error); ok {
+		r1 = rf(ctx, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListBookmarks provides a mock function with given fields: ctx, channel
+func (_m *MockClient) ListBookmarks(ctx context.Context, channel string) ([]Bookmark, error) {
+	ret := _m.Called(ctx, channel)
+
+	var r0 []Bookmark
+	if rf, ok := ret.Get(0).(func(context.Context, string) []Bookmark); ok {
+		r0 = rf(ctx, channel)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Bookmark)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, channel)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListFiles provides a mock function with given fields: ctx, opts
+func (_m *MockClient) ListFiles(ctx context.Context, opts ...ListOption) (FilesList, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 FilesList
+	if rf, ok := ret.Get(0).(func(context.Context, ...ListOption) FilesList); ok {
+		r0 = rf(ctx, opts...)
+	} else {
+		r0 = ret.Get(0).(FilesList)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ...ListOption) error); ok {
+		r1 = rf(ctx, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPins provides a mock function with given fields: ctx, channel
+func (_m *MockClient) ListPins(ctx context.Context, channel string) ([]Pin, error) {
+	ret := _m.Called(ctx, channel)
+
+	var r0 []Pin
+	if rf, ok := ret.Get(0).(func(context.Context, string) []Pin); ok {
+		r0 = rf(ctx, channel)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Pin)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, channel)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListReactions provides a mock function with given fields: ctx, opts
+func (_m *MockClient) ListReactions(ctx context.Context, opts ...ListOption) (ReactedItems, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 ReactedItems
+	if rf, ok := ret.Get(0).(func(context.Context, ...ListOption) ReactedItems); ok {
+		r0 = rf(ctx, opts...)
+	} else {
+		r0 = ret.Get(0).(ReactedItems)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ...ListOption) error); ok {
+		r1 = rf(ctx, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OpenView provides a mock function with given fields: ctx, triggerID, view
+func (_m *MockClient) OpenView(ctx context.Context, triggerID string, view View) (View, error) {
+	ret := _m.Called(ctx, triggerID, view)
+
+	var r0 View
+	if rf, ok := ret.Get(0).(func(context.Context, string, View) View); ok {
+		r0 = rf(ctx, triggerID, view)
+	} else {
+		r0 = ret.Get(0).(View)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, View) error); ok {
+		r1 = rf(ctx, triggerID, view)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PostMessage provides a mock function with given fields: ctx, message, channel, opts
 func (_m *MockClient) PostMessage(ctx context.Context, message string, channel string, opts ...MsgOption) (MessagePosted, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
@@ -59,6 +407,134 @@ func (_m *MockClient) PostMessage(ctx context.Context, message string, channel s
 	return r0, r1
 }
 
+// PublishHomeView provides a mock function with given fields: ctx, userID, hash, view
+func (_m *MockClient) PublishHomeView(ctx context.Context, userID string, hash string, view View) (View, error) {
+	ret := _m.Called(ctx, userID, hash, view)
+
+	var r0 View
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, View) View); ok {
+		r0 = rf(ctx, userID, hash, view)
+	} else {
+		r0 = ret.Get(0).(View)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, View) error); ok {
+		r1 = rf(ctx, userID, hash, view)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PushView provides a mock function with given fields: ctx, triggerID, view
+func (_m *MockClient) PushView(ctx context.Context, triggerID string, view View) (View, error) {
+	ret := _m.Called(ctx, triggerID, view)
+
+	var r0 View
+	if rf, ok := ret.Get(0).(func(context.Context, string, View) View); ok {
+		r0 = rf(ctx, triggerID, view)
+	} else {
+		r0 = ret.Get(0).(View)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, View) error); ok {
+		r1 = rf(ctx, triggerID, view)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveBookmark provides a mock function with given fields: ctx, channel, bookmarkID
+func (_m *MockClient) RemoveBookmark(ctx context.Context, channel string, bookmarkID string) error {
+	ret := _m.Called(ctx, channel, bookmarkID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, channel, bookmarkID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemovePin provides a mock function with given fields: ctx, msg
+func (_m *MockClient) RemovePin(ctx context.Context, msg MessageRef) error {
+	ret := _m.Called(ctx, msg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, MessageRef) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveReaction provides a mock function with given fields: ctx, name, item
+func (_m *MockClient) RemoveReaction(ctx context.Context, name string, item ItemRef) error {
+	ret := _m.Called(ctx, name, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ItemRef) error); ok {
+		r0 = rf(ctx, name, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeFilePublicURL provides a mock function with given fields: ctx, fileID
+func (_m *MockClient) RevokeFilePublicURL(ctx context.Context, fileID string) (File, error) {
+	ret := _m.Called(ctx, fileID)
+
+	var r0 File
+	if rf, ok := ret.Get(0).(func(context.Context, string) File); ok {
+		r0 = rf(ctx, fileID)
+	} else {
+		r0 = ret.Get(0).(File)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, fileID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SendFormRequest provides a mock function with given fields: ctx, path, data
+func (_m *MockClient) SendFormRequest(ctx context.Context, path string, data []byte) ([]byte, error) {
+	ret := _m.Called(ctx, path, data)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) []byte); ok {
+		r0 = rf(ctx, path, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []byte) error); ok {
+		r1 = rf(ctx, path, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SendRequest provides a mock function with given fields: ctx, method, path, data
 func (_m *MockClient) SendRequest(ctx context.Context, method string, path string, data []byte) ([]byte, error) {
 	ret := _m.Called(ctx, method, path, data)
@@ -81,3 +557,96 @@ func (_m *MockClient) SendRequest(ctx context.Context, method string, path strin
 
 	return r0, r1
 }
+
+// ShareFilePublicURL provides a mock function with given fields: ctx, fileID
+func (_m *MockClient) ShareFilePublicURL(ctx context.Context, fileID string) (File, error) {
+	ret := _m.Called(ctx, fileID)
+
+	var r0 File
+	if rf, ok := ret.Get(0).(func(context.Context, string) File); ok {
+		r0 = rf(ctx, fileID)
+	} else {
+		r0 = ret.Get(0).(File)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, fileID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TokenInfo provides a mock function with given fields: ctx
+func (_m *MockClient) TokenInfo(ctx context.Context) (TokenInfo, error) {
+	ret := _m.Called(ctx)
+
+	var r0 TokenInfo
+	if rf, ok := ret.Get(0).(func(context.Context) TokenInfo); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(TokenInfo)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateView provides a mock function with given fields: ctx, viewID, hash, view
+func (_m *MockClient) UpdateView(ctx context.Context, viewID string, hash string, view View) (View, error) {
+	ret := _m.Called(ctx, viewID, hash, view)
+
+	var r0 View
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, View) View); ok {
+		r0 = rf(ctx, viewID, hash, view)
+	} else {
+		r0 = ret.Get(0).(View)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, View) error); ok {
+		r1 = rf(ctx, viewID, hash, view)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UploadFile provides a mock function with given fields: ctx, r, opts
+func (_m *MockClient) UploadFile(ctx context.Context, r io.Reader, opts ...UploadOption) ([]File, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, r)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 []File
+	if rf, ok := ret.Get(0).(func(context.Context, io.Reader, ...UploadOption) []File); ok {
+		r0 = rf(ctx, r, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]File)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, io.Reader, ...UploadOption) error); ok {
+		r1 = rf(ctx, r, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,113 @@
+// Package oauth - file installation store
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+type (
+	// FileStore installation store keeping every installation as a json file in a directory
+	FileStore struct {
+		mu  sync.RWMutex
+		dir string
+	}
+)
+
+// NewFileStore is file store constructor, creates the directory if it doesn't exist
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("can't create store directory: %w", err)
+	}
+
+	return &FileStore{dir: dir}, nil
+}
+
+// Save implementation
+func (s *FileStore) Save(_ context.Context, installation Installation) error {
+	data, err := json.MarshalIndent(installation, "", "  ")
+	if err != nil {
+		return fmt.Errorf("can't marshal installation: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tmp, err := ioutil.TempFile(s.dir, ".installation-*")
+	if err != nil {
+		return fmt.Errorf("can't create installation file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("can't write installation file: %w", err)
+	}
+
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("can't write installation file: %w", err)
+	}
+
+	if err = os.Rename(tmp.Name(), s.path(keyOf(installation))); err != nil {
+		return fmt.Errorf("can't write installation file: %w", err)
+	}
+
+	return nil
+}
+
+// Find implementation
+func (s *FileStore) Find(_ context.Context, enterpriseID, teamID string) (Installation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, key := range lookupKeys(enterpriseID, teamID) {
+		data, err := ioutil.ReadFile(s.path(key))
+		if os.IsNotExist(err) {
+			continue
+		}
+
+		if err != nil {
+			return Installation{}, fmt.Errorf("can't read installation file: %w", err)
+		}
+
+		var installation Installation
+		if err = json.Unmarshal(data, &installation); err != nil {
+			return Installation{}, fmt.Errorf("can't unmarshal installation: %w", err)
+		}
+
+		return installation, nil
+	}
+
+	return Installation{}, ErrInstallationNotFound
+}
+
+// Delete implementation
+func (s *FileStore) Delete(_ context.Context, enterpriseID, teamID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err := os.Remove(s.path(installationKey{enterpriseID: enterpriseID, teamID: teamID}))
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("can't delete installation file: %w", err)
+	}
+
+	return nil
+}
+
+func (s *FileStore) path(key installationKey) string {
+	enterpriseID, teamID := key.enterpriseID, key.teamID
+	if len(enterpriseID) == 0 {
+		enterpriseID = "none"
+	}
+
+	if len(teamID) == 0 {
+		teamID = "none"
+	}
+
+	return filepath.Join(s.dir, filepath.Base(enterpriseID)+"-"+filepath.Base(teamID)+".json")
+}
@@ -0,0 +1,216 @@
+// Package oauth - installation handler
+package oauth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kryabinin/go-slack"
+)
+
+const (
+	stateCookie     = "slack_oauth_state"
+	defaultStateTTL = 10 * time.Minute
+)
+
+var (
+	// ErrInvalidState state parameter of the callback is missing, forged, expired or issued to another browser
+	ErrInvalidState = errors.New("invalid oauth state")
+)
+
+type (
+	// Handler http handler of the installation flow. Requests without code and error query parameters are redirected
+	// to slack authorization page, others are handled as the callback, so the same url can be used for both
+	Handler struct {
+		clientID     string
+		clientSecret string
+		store        InstallationStore
+
+		scopes       []string
+		userScopes   []string
+		redirectUrl  string
+		baseUrl      string
+		authorizeUrl string
+		stateTTL     time.Duration
+		httpClient   slack.HTTPClient
+		onSuccess    func(w http.ResponseWriter, r *http.Request, installation Installation)
+		onError      func(w http.ResponseWriter, r *http.Request, err error)
+		now          func() time.Time
+	}
+)
+
+// NewHandler is installation handler constructor
+func NewHandler(clientID, clientSecret string, store InstallationStore, opts ...Option) *Handler {
+	h := &Handler{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		store:        store,
+		baseUrl:      defaultBaseUrl,
+		authorizeUrl: defaultAuthorizeUrl,
+		stateTTL:     defaultStateTTL,
+		httpClient:   &http.Client{},
+		onSuccess:    defaultOnSuccess,
+		onError:      defaultOnError,
+		now:          time.Now,
+	}
+
+	for _, opt := range opts {
+		opt.apply(h)
+	}
+
+	return h
+}
+
+// ServeHTTP implementation
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if len(query.Get("code")) == 0 && len(query.Get("error")) == 0 {
+		h.install(w, r)
+		return
+	}
+
+	h.callback(w, r)
+}
+
+// AuthorizeUrl builds url of slack authorization page with the state
+func (h *Handler) AuthorizeUrl(state string) string {
+	params := url.Values{
+		"client_id": {h.clientID},
+		"scope":     {strings.Join(h.scopes, ",")},
+		"state":     {state},
+	}
+
+	if len(h.userScopes) > 0 {
+		params.Set("user_scope", strings.Join(h.userScopes, ","))
+	}
+
+	if len(h.redirectUrl) > 0 {
+		params.Set("redirect_uri", h.redirectUrl)
+	}
+
+	return h.authorizeUrl + "?" + params.Encode()
+}
+
+func (h *Handler) install(w http.ResponseWriter, r *http.Request) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		h.onError(w, r, fmt.Errorf("can't generate state: %w", err))
+		return
+	}
+
+	state := h.signState(hex.EncodeToString(nonce), h.now())
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     stateCookie,
+		Value:    state,
+		Path:     "/",
+		MaxAge:   int(h.stateTTL.Seconds()),
+		HttpOnly: true,
+		Secure:   isSecure(r),
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	http.Redirect(w, r, h.AuthorizeUrl(state), http.StatusFound)
+}
+
+func (h *Handler) callback(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     stateCookie,
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   isSecure(r),
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	if err := h.verifyState(r); err != nil {
+		h.onError(w, r, err)
+		return
+	}
+
+	if slackErr := query.Get("error"); len(slackErr) > 0 {
+		h.onError(w, r, fmt.Errorf("installation is canceled: %s", slackErr))
+		return
+	}
+
+	installation, err := h.Exchange(r.Context(), query.Get("code"))
+	if err != nil {
+		h.onError(w, r, err)
+		return
+	}
+
+	if err = h.store.Save(r.Context(), installation); err != nil {
+		h.onError(w, r, fmt.Errorf("can't save installation: %w", err))
+		return
+	}
+
+	h.onSuccess(w, r, installation)
+}
+
+func (h *Handler) signState(nonce string, issuedAt time.Time) string {
+	payload := nonce + "." + strconv.FormatInt(issuedAt.Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte(h.clientSecret))
+	mac.Write([]byte(payload))
+
+	return payload + "." + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (h *Handler) verifyState(r *http.Request) error {
+	state := r.URL.Query().Get("state")
+
+	cookie, err := r.Cookie(stateCookie)
+	if err != nil || !hmac.Equal([]byte(cookie.Value), []byte(state)) {
+		return ErrInvalidState
+	}
+
+	parts := strings.Split(state, ".")
+	if len(parts) != 3 {
+		return ErrInvalidState
+	}
+
+	issuedAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return ErrInvalidState
+	}
+
+	if !hmac.Equal([]byte(h.signState(parts[0], time.Unix(issuedAt, 0))), []byte(state)) {
+		return ErrInvalidState
+	}
+
+	if h.now().Sub(time.Unix(issuedAt, 0)) > h.stateTTL {
+		return ErrInvalidState
+	}
+
+	return nil
+}
+
+func isSecure(r *http.Request) bool {
+	return r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https"
+}
+
+func defaultOnSuccess(w http.ResponseWriter, _ *http.Request, _ Installation) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("The app is installed, you can close this page."))
+}
+
+func defaultOnError(w http.ResponseWriter, _ *http.Request, err error) {
+	status := http.StatusInternalServerError
+	if errors.Is(err, ErrInvalidState) {
+		status = http.StatusBadRequest
+	}
+
+	http.Error(w, "Installation failed: "+err.Error(), status)
+}
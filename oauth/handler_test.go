@@ -0,0 +1,207 @@
+package oauth_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-slack"
+	"github.com/kryabinin/go-slack/oauth"
+)
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	var (
+		baseUrl     = "http://test.slack.com/api"
+		redirectUrl = "https://app.example.com/slack/install"
+	)
+
+	install := func(h http.Handler) (*http.Cookie, string) {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, redirectUrl, nil))
+
+		assert.Equal(t, http.StatusFound, rec.Code)
+		location, err := url.Parse(rec.Header().Get("Location"))
+		assert.NoError(t, err)
+
+		cookies := rec.Result().Cookies()
+		assert.Len(t, cookies, 1)
+
+		return cookies[0], location.Query().Get("state")
+	}
+
+	callback := func(h http.Handler, cookie *http.Cookie, query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, redirectUrl+"?"+query, nil)
+		if cookie != nil {
+			req.AddCookie(cookie)
+		}
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	t.Run("install redirect", func(t *testing.T) {
+		h := oauth.NewHandler(
+			"client_id",
+			"client_secret",
+			oauth.NewMemoryStore(),
+			oauth.WithScopes("chat:write", "commands"),
+			oauth.WithUserScopes("search:read"),
+			oauth.WithRedirectUrl(redirectUrl),
+		)
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, redirectUrl, nil))
+
+		assert.Equal(t, http.StatusFound, rec.Code)
+
+		location, err := url.Parse(rec.Header().Get("Location"))
+		assert.NoError(t, err)
+		assert.Equal(t, "slack.com", location.Host)
+		assert.Equal(t, "/oauth/v2/authorize", location.Path)
+		assert.Equal(t, "client_id", location.Query().Get("client_id"))
+		assert.Equal(t, "chat:write,commands", location.Query().Get("scope"))
+		assert.Equal(t, "search:read", location.Query().Get("user_scope"))
+		assert.Equal(t, redirectUrl, location.Query().Get("redirect_uri"))
+		assert.NotEmpty(t, location.Query().Get("state"))
+	})
+
+	t.Run("successful callback", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, baseUrl+"/"+"oauth.v2.access", req.URL.String())
+			assert.Equal(t, "application/x-www-form-urlencoded", req.Header.Get("Content-Type"))
+
+			body, err := ioutil.ReadAll(req.Body)
+			assert.NoError(t, err)
+
+			params, err := url.ParseQuery(string(body))
+			assert.NoError(t, err)
+			assert.Equal(t, "client_id", params.Get("client_id"))
+			assert.Equal(t, "client_secret", params.Get("client_secret"))
+			assert.Equal(t, "test_code", params.Get("code"))
+			assert.Equal(t, redirectUrl, params.Get("redirect_uri"))
+		}).Return(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":true,"app_id":"A1","access_token":"xoxb-1",` +
+				`"token_type":"bot","scope":"chat:write,commands","bot_user_id":"UB","refresh_token":"xoxe-1",` +
+				`"expires_in":43200,"team":{"id":"T1","name":"Team"},"enterprise":null,` +
+				`"authed_user":{"id":"U1","scope":"search:read","access_token":"xoxp-1","token_type":"user"},` +
+				`"incoming_webhook":{"channel":"#general","channel_id":"C1","url":"https://hooks.slack.com/x"}}`))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		var installed oauth.Installation
+		store := oauth.NewMemoryStore()
+		h := oauth.NewHandler(
+			"client_id",
+			"client_secret",
+			store,
+			oauth.WithBaseUrl(baseUrl),
+			oauth.WithHttpClient(httpClient),
+			oauth.WithRedirectUrl(redirectUrl),
+			oauth.WithSuccessHandler(func(w http.ResponseWriter, r *http.Request, installation oauth.Installation) {
+				installed = installation
+				w.WriteHeader(http.StatusNoContent)
+			}),
+		)
+
+		cookie, state := install(h)
+		rec := callback(h, cookie, url.Values{"code": {"test_code"}, "state": {state}}.Encode())
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, "A1", installed.AppID)
+		assert.Equal(t, "T1", installed.TeamID)
+		assert.Equal(t, "Team", installed.TeamName)
+		assert.Equal(t, "xoxb-1", installed.BotToken)
+		assert.Equal(t, []string{"chat:write", "commands"}, installed.BotScopes)
+		assert.Equal(t, "xoxe-1", installed.BotRefreshToken)
+		assert.False(t, installed.BotTokenExpiresAt.IsZero())
+		assert.Equal(t, "xoxp-1", installed.UserToken)
+		assert.Equal(t, []string{"search:read"}, installed.UserScopes)
+		assert.Equal(t, "https://hooks.slack.com/x", installed.IncomingWebhook.Url)
+
+		stored, err := store.Find(context.Background(), "", "T1")
+		assert.NoError(t, err)
+		assert.Equal(t, installed, stored)
+	})
+
+	t.Run("invalid state", func(t *testing.T) {
+		testCases := []struct {
+			name  string
+			query func(state string) string
+			drop  bool
+		}{
+			{
+				name:  "missing cookie",
+				query: func(state string) string { return url.Values{"code": {"c"}, "state": {state}}.Encode() },
+				drop:  true,
+			},
+			{
+				name:  "forged state",
+				query: func(string) string { return url.Values{"code": {"c"}, "state": {"a.1.b"}}.Encode() },
+			},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				var callbackErr error
+				h := oauth.NewHandler(
+					"client_id",
+					"client_secret",
+					oauth.NewMemoryStore(),
+					oauth.WithHttpClient(new(slack.MockHTTPClient)),
+					oauth.WithErrorHandler(func(w http.ResponseWriter, r *http.Request, err error) {
+						callbackErr = err
+					}),
+				)
+
+				cookie, state := install(h)
+				if testCase.drop {
+					cookie = nil
+				}
+
+				callback(h, cookie, testCase.query(state))
+				assert.True(t, errors.Is(callbackErr, oauth.ErrInvalidState))
+			})
+		}
+	})
+
+	t.Run("access denied", func(t *testing.T) {
+		h := oauth.NewHandler("client_id", "client_secret", oauth.NewMemoryStore())
+
+		cookie, state := install(h)
+		rec := callback(h, cookie, url.Values{"error": {"access_denied"}, "state": {state}}.Encode())
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Contains(t, rec.Body.String(), "access_denied")
+	})
+
+	t.Run("slack respond with error", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":false,"error":"invalid_code"}`))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		store := oauth.NewMemoryStore()
+		h := oauth.NewHandler("client_id", "client_secret", store, oauth.WithHttpClient(httpClient))
+
+		cookie, state := install(h)
+		rec := callback(h, cookie, url.Values{"code": {"c"}, "state": {state}}.Encode())
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Contains(t, rec.Body.String(), "invalid_code")
+	})
+}
@@ -0,0 +1,208 @@
+// Package oauth - slack app installation with OAuth v2 flow
+package oauth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	defaultBaseUrl      = "https://slack.com/api/"
+	defaultAuthorizeUrl = "https://slack.com/oauth/v2/authorize"
+)
+
+type (
+	// Installation result of the app installation to a workspace or an Enterprise Grid organization
+	Installation struct {
+		// AppID identifier of the installed app
+		AppID string `json:"app_id"`
+
+		// TeamID workspace identifier, empty for organization wide installations
+		TeamID string `json:"team_id"`
+
+		// TeamName workspace name
+		TeamName string `json:"team_name"`
+
+		// EnterpriseID Enterprise Grid organization identifier
+		EnterpriseID string `json:"enterprise_id"`
+
+		// EnterpriseName Enterprise Grid organization name
+		EnterpriseName string `json:"enterprise_name"`
+
+		// IsEnterpriseInstall true if the app is installed to the whole organization
+		IsEnterpriseInstall bool `json:"is_enterprise_install"`
+
+		// BotToken bot token, starts with xoxb-
+		BotToken string `json:"bot_token"`
+
+		// BotUserID user identifier of the bot
+		BotUserID string `json:"bot_user_id"`
+
+		// BotScopes scopes granted to the bot token
+		BotScopes []string `json:"bot_scopes"`
+
+		// BotRefreshToken refresh token of the bot token, set only when token rotation is enabled
+		BotRefreshToken string `json:"bot_refresh_token,omitempty"`
+
+		// BotTokenExpiresAt expiration time of the bot token, zero when token rotation is disabled
+		BotTokenExpiresAt time.Time `json:"bot_token_expires_at,omitempty"`
+
+		// UserID user who installed the app
+		UserID string `json:"user_id"`
+
+		// UserToken user token, starts with xoxp-. Set only when user scopes are requested
+		UserToken string `json:"user_token,omitempty"`
+
+		// UserScopes scopes granted to the user token
+		UserScopes []string `json:"user_scopes,omitempty"`
+
+		// UserRefreshToken refresh token of the user token, set only when token rotation is enabled
+		UserRefreshToken string `json:"user_refresh_token,omitempty"`
+
+		// UserTokenExpiresAt expiration time of the user token, zero when token rotation is disabled
+		UserTokenExpiresAt time.Time `json:"user_token_expires_at,omitempty"`
+
+		// IncomingWebhook incoming webhook created with the installation, set only for incoming-webhook scope
+		IncomingWebhook *IncomingWebhook `json:"incoming_webhook,omitempty"`
+
+		// InstalledAt time of the installation
+		InstalledAt time.Time `json:"installed_at"`
+	}
+
+	// IncomingWebhook incoming webhook entity
+	IncomingWebhook struct {
+		// Channel name of the channel the webhook posts to
+		Channel string `json:"channel"`
+
+		// ChannelID identifier of the channel the webhook posts to
+		ChannelID string `json:"channel_id"`
+
+		// ConfigurationUrl url to configure the webhook
+		ConfigurationUrl string `json:"configuration_url"`
+
+		// Url url to post messages to
+		Url string `json:"url"`
+	}
+
+	accessApiResponse struct {
+		Ok                  bool             `json:"ok"`
+		Error               string           `json:"error"`
+		AppID               string           `json:"app_id"`
+		AccessToken         string           `json:"access_token"`
+		TokenType           string           `json:"token_type"`
+		Scope               string           `json:"scope"`
+		BotUserID           string           `json:"bot_user_id"`
+		RefreshToken        string           `json:"refresh_token"`
+		ExpiresIn           int64            `json:"expires_in"`
+		Team                namedEntity      `json:"team"`
+		Enterprise          namedEntity      `json:"enterprise"`
+		IsEnterpriseInstall bool             `json:"is_enterprise_install"`
+		IncomingWebhook     *IncomingWebhook `json:"incoming_webhook"`
+		AuthedUser          struct {
+			ID           string `json:"id"`
+			Scope        string `json:"scope"`
+			AccessToken  string `json:"access_token"`
+			TokenType    string `json:"token_type"`
+			RefreshToken string `json:"refresh_token"`
+			ExpiresIn    int64  `json:"expires_in"`
+		} `json:"authed_user"`
+	}
+
+	namedEntity struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+)
+
+// Exchange exchanges a temporary authorization code for an installation with oauth.v2.access method
+func (h *Handler) Exchange(ctx context.Context, code string) (Installation, error) {
+	params := url.Values{
+		"client_id":     {h.clientID},
+		"client_secret": {h.clientSecret},
+		"code":          {code},
+	}
+
+	if len(h.redirectUrl) > 0 {
+		params.Set("redirect_uri", h.redirectUrl)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, h.baseUrl+"/oauth.v2.access", bytes.NewReader([]byte(params.Encode())))
+	if nil != err {
+		return Installation{}, fmt.Errorf("can't create http request: %w", err)
+	}
+
+	req = req.WithContext(ctx)
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+	var resp *http.Response
+	if resp, err = h.httpClient.Do(req); nil != err {
+		return Installation{}, fmt.Errorf("can't send http request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if http.StatusOK != resp.StatusCode {
+		return Installation{}, fmt.Errorf("slack respond with %d status code", resp.StatusCode)
+	}
+
+	var body []byte
+	if body, err = ioutil.ReadAll(resp.Body); nil != err {
+		return Installation{}, fmt.Errorf("can't read response body: %w", err)
+	}
+
+	var access accessApiResponse
+	if err = json.Unmarshal(body, &access); err != nil {
+		return Installation{}, fmt.Errorf("can't unmarshal response: %w", err)
+	}
+
+	if !access.Ok {
+		return Installation{}, fmt.Errorf("slack respond with error: %s", access.Error)
+	}
+
+	return access.installation(h.now()), nil
+}
+
+func (r accessApiResponse) installation(now time.Time) Installation {
+	installation := Installation{
+		AppID:               r.AppID,
+		TeamID:              r.Team.ID,
+		TeamName:            r.Team.Name,
+		EnterpriseID:        r.Enterprise.ID,
+		EnterpriseName:      r.Enterprise.Name,
+		IsEnterpriseInstall: r.IsEnterpriseInstall,
+		BotToken:            r.AccessToken,
+		BotUserID:           r.BotUserID,
+		BotScopes:           splitScopes(r.Scope),
+		BotRefreshToken:     r.RefreshToken,
+		UserID:              r.AuthedUser.ID,
+		UserToken:           r.AuthedUser.AccessToken,
+		UserScopes:          splitScopes(r.AuthedUser.Scope),
+		UserRefreshToken:    r.AuthedUser.RefreshToken,
+		IncomingWebhook:     r.IncomingWebhook,
+		InstalledAt:         now,
+	}
+
+	if r.ExpiresIn > 0 {
+		installation.BotTokenExpiresAt = now.Add(time.Duration(r.ExpiresIn) * time.Second)
+	}
+
+	if r.AuthedUser.ExpiresIn > 0 {
+		installation.UserTokenExpiresAt = now.Add(time.Duration(r.AuthedUser.ExpiresIn) * time.Second)
+	}
+
+	return installation
+}
+
+func splitScopes(scope string) []string {
+	if len(scope) == 0 {
+		return nil
+	}
+
+	return strings.Split(scope, ",")
+}
@@ -0,0 +1,133 @@
+// Package oauth - handler options
+package oauth
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/kryabinin/go-slack"
+)
+
+type (
+	// Option to use optional parameters in installation handler
+	Option interface {
+		apply(h *Handler)
+	}
+
+	withScopes struct {
+		scopes []string
+	}
+
+	withUserScopes struct {
+		scopes []string
+	}
+
+	withRedirectUrl struct {
+		redirectUrl string
+	}
+
+	withHttpClient struct {
+		httpClient slack.HTTPClient
+	}
+
+	withBaseUrl struct {
+		baseUrl string
+	}
+
+	withAuthorizeUrl struct {
+		authorizeUrl string
+	}
+
+	withStateTTL struct {
+		ttl time.Duration
+	}
+
+	withSuccessHandler struct {
+		handler func(w http.ResponseWriter, r *http.Request, installation Installation)
+	}
+
+	withErrorHandler struct {
+		handler func(w http.ResponseWriter, r *http.Request, err error)
+	}
+)
+
+// WithScopes sets scopes requested for the bot token
+func WithScopes(scopes ...string) Option {
+	return &withScopes{scopes: scopes}
+}
+
+func (opt *withScopes) apply(h *Handler) {
+	h.scopes = opt.scopes
+}
+
+// WithUserScopes sets scopes requested for the user token
+func WithUserScopes(scopes ...string) Option {
+	return &withUserScopes{scopes: scopes}
+}
+
+func (opt *withUserScopes) apply(h *Handler) {
+	h.userScopes = opt.scopes
+}
+
+// WithRedirectUrl sets redirect url, must match one of the redirect urls configured for the app
+func WithRedirectUrl(redirectUrl string) Option {
+	return &withRedirectUrl{redirectUrl: redirectUrl}
+}
+
+func (opt *withRedirectUrl) apply(h *Handler) {
+	h.redirectUrl = opt.redirectUrl
+}
+
+// WithHttpClient replaces default http client
+func WithHttpClient(httpClient slack.HTTPClient) Option {
+	return &withHttpClient{httpClient: httpClient}
+}
+
+func (opt *withHttpClient) apply(h *Handler) {
+	h.httpClient = opt.httpClient
+}
+
+// WithBaseUrl replaces default api base url
+func WithBaseUrl(baseUrl string) Option {
+	return &withBaseUrl{baseUrl: baseUrl}
+}
+
+func (opt *withBaseUrl) apply(h *Handler) {
+	h.baseUrl = opt.baseUrl
+}
+
+// WithAuthorizeUrl replaces default url of slack authorization page
+func WithAuthorizeUrl(authorizeUrl string) Option {
+	return &withAuthorizeUrl{authorizeUrl: authorizeUrl}
+}
+
+func (opt *withAuthorizeUrl) apply(h *Handler) {
+	h.authorizeUrl = opt.authorizeUrl
+}
+
+// WithStateTTL sets how long the installation state is valid, 10 minutes by default
+func WithStateTTL(ttl time.Duration) Option {
+	return &withStateTTL{ttl: ttl}
+}
+
+func (opt *withStateTTL) apply(h *Handler) {
+	h.stateTTL = opt.ttl
+}
+
+// WithSuccessHandler replaces default response on successful installation
+func WithSuccessHandler(handler func(w http.ResponseWriter, r *http.Request, installation Installation)) Option {
+	return &withSuccessHandler{handler: handler}
+}
+
+func (opt *withSuccessHandler) apply(h *Handler) {
+	h.onSuccess = opt.handler
+}
+
+// WithErrorHandler replaces default response on failed installation
+func WithErrorHandler(handler func(w http.ResponseWriter, r *http.Request, err error)) Option {
+	return &withErrorHandler{handler: handler}
+}
+
+func (opt *withErrorHandler) apply(h *Handler) {
+	h.onError = opt.handler
+}
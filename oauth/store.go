@@ -0,0 +1,96 @@
+// Package oauth - installation store
+package oauth
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+var (
+	// ErrInstallationNotFound installation is not found in the store
+	ErrInstallationNotFound = errors.New("installation not found")
+)
+
+type (
+	// InstallationStore persists installations. Organization wide installations are stored with empty team id
+	InstallationStore interface {
+		// Save saves installation replacing the previous one of the same team
+		Save(ctx context.Context, installation Installation) error
+
+		// Find finds installation of a team. Falls back to the organization wide installation if there is no team
+		// installation. Returns ErrInstallationNotFound if there is no installation
+		Find(ctx context.Context, enterpriseID, teamID string) (Installation, error)
+
+		// Delete deletes installation of a team
+		Delete(ctx context.Context, enterpriseID, teamID string) error
+	}
+
+	// MemoryStore in-memory installation store
+	MemoryStore struct {
+		mu            sync.RWMutex
+		installations map[installationKey]Installation
+	}
+
+	installationKey struct {
+		enterpriseID string
+		teamID       string
+	}
+)
+
+// NewMemoryStore is in-memory store constructor
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{installations: make(map[installationKey]Installation)}
+}
+
+// Save implementation
+func (s *MemoryStore) Save(_ context.Context, installation Installation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.installations[keyOf(installation)] = installation
+
+	return nil
+}
+
+// Find implementation
+func (s *MemoryStore) Find(_ context.Context, enterpriseID, teamID string) (Installation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, key := range lookupKeys(enterpriseID, teamID) {
+		if installation, ok := s.installations[key]; ok {
+			return installation, nil
+		}
+	}
+
+	return Installation{}, ErrInstallationNotFound
+}
+
+// Delete implementation
+func (s *MemoryStore) Delete(_ context.Context, enterpriseID, teamID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.installations, installationKey{enterpriseID: enterpriseID, teamID: teamID})
+
+	return nil
+}
+
+func keyOf(installation Installation) installationKey {
+	key := installationKey{enterpriseID: installation.EnterpriseID, teamID: installation.TeamID}
+	if installation.IsEnterpriseInstall {
+		key.teamID = ""
+	}
+
+	return key
+}
+
+func lookupKeys(enterpriseID, teamID string) []installationKey {
+	keys := []installationKey{{enterpriseID: enterpriseID, teamID: teamID}}
+	if len(enterpriseID) > 0 && len(teamID) > 0 {
+		keys = append(keys, installationKey{enterpriseID: enterpriseID})
+	}
+
+	return keys
+}
@@ -0,0 +1,62 @@
+package oauth_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kryabinin/go-slack/oauth"
+)
+
+func TestInstallationStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "oauth_store")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	fileStore, err := oauth.NewFileStore(dir)
+	assert.NoError(t, err)
+
+	stores := map[string]oauth.InstallationStore{
+		"memory": oauth.NewMemoryStore(),
+		"file":   fileStore,
+	}
+
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			_, err := store.Find(ctx, "", "T1")
+			assert.Equal(t, oauth.ErrInstallationNotFound, err)
+
+			team := oauth.Installation{TeamID: "T1", BotToken: "xoxb-1", BotScopes: []string{"chat:write"}}
+			assert.NoError(t, store.Save(ctx, team))
+
+			found, err := store.Find(ctx, "", "T1")
+			assert.NoError(t, err)
+			assert.Equal(t, team, found)
+
+			team.BotToken = "xoxb-2"
+			assert.NoError(t, store.Save(ctx, team))
+
+			found, err = store.Find(ctx, "", "T1")
+			assert.NoError(t, err)
+			assert.Equal(t, "xoxb-2", found.BotToken)
+
+			org := oauth.Installation{EnterpriseID: "E1", TeamID: "T2", IsEnterpriseInstall: true, BotToken: "xoxb-org"}
+			assert.NoError(t, store.Save(ctx, org))
+
+			found, err = store.Find(ctx, "E1", "T3")
+			assert.NoError(t, err)
+			assert.Equal(t, "xoxb-org", found.BotToken)
+
+			assert.NoError(t, store.Delete(ctx, "", "T1"))
+			assert.NoError(t, store.Delete(ctx, "", "T1"))
+
+			_, err = store.Find(ctx, "", "T1")
+			assert.Equal(t, oauth.ErrInstallationNotFound, err)
+		})
+	}
+}
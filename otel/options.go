@@ -0,0 +1,73 @@
+// Package otel - options
+package otel
+
+import (
+	global "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	// Option to use optional parameters in the instrumentation
+	Option interface {
+		apply(cfg *config)
+	}
+
+	config struct {
+		tracerProvider trace.TracerProvider
+		meterProvider  metric.MeterProvider
+		teamID         string
+	}
+
+	withTracerProvider struct {
+		provider trace.TracerProvider
+	}
+
+	withMeterProvider struct {
+		provider metric.MeterProvider
+	}
+
+	withTeamID struct {
+		teamID string
+	}
+)
+
+// WithTracerProvider sets tracer provider, the global one by default
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return &withTracerProvider{provider: provider}
+}
+
+func (opt *withTracerProvider) apply(cfg *config) {
+	cfg.tracerProvider = opt.provider
+}
+
+// WithMeterProvider sets meter provider, the global one by default
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return &withMeterProvider{provider: provider}
+}
+
+func (opt *withMeterProvider) apply(cfg *config) {
+	cfg.meterProvider = opt.provider
+}
+
+// WithTeamID sets workspace attribute of calls without team_id parameter, e.g. for clients of a single installation
+func WithTeamID(teamID string) Option {
+	return &withTeamID{teamID: teamID}
+}
+
+func (opt *withTeamID) apply(cfg *config) {
+	cfg.teamID = opt.teamID
+}
+
+func newConfig(opts []Option) config {
+	cfg := config{
+		tracerProvider: global.GetTracerProvider(),
+		meterProvider:  global.GetMeterProvider(),
+	}
+
+	for _, opt := range opts {
+		opt.apply(&cfg)
+	}
+
+	return cfg
+}
@@ -0,0 +1,182 @@
+// Package otel - OpenTelemetry instrumentation of slack client. Add the middleware to the client:
+//
+//	client := slack.NewClient(token, slack.WithMiddleware(otel.Middleware()))
+//
+// Global tracer and meter providers are used by default, they are no-op until an SDK is installed
+package otel
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/kryabinin/go-slack"
+)
+
+const instrumentationName = "github.com/kryabinin/go-slack/otel"
+
+// Attribute keys of spans and metrics
+const (
+	AttrMethod        = attribute.Key("slack.method")
+	AttrTeam          = attribute.Key("slack.team_id")
+	AttrChannel       = attribute.Key("slack.channel")
+	AttrErrorCode     = attribute.Key("slack.error")
+	AttrRetryCount    = attribute.Key("slack.retry_count")
+	AttrRateLimitWait = attribute.Key("slack.rate_limit_wait_ms")
+	AttrTokenType     = attribute.Key("slack.token_type")
+	AttrStatusCode    = attribute.Key("http.response.status_code")
+)
+
+type (
+	instruments struct {
+		tracer      trace.Tracer
+		duration    metric.Float64Histogram
+		errors      metric.Int64Counter
+		rateLimited metric.Int64Counter
+		teamID      string
+	}
+
+	envelope struct {
+		Ok      bool   `json:"ok"`
+		Error   string `json:"error"`
+		Channel string `json:"channel"`
+		TeamID  string `json:"team_id"`
+	}
+)
+
+// Middleware makes middleware tracing and measuring every api call of the client
+func Middleware(opts ...Option) slack.Middleware {
+	cfg := newConfig(opts)
+
+	tracer := cfg.tracerProvider.Tracer(instrumentationName)
+	meter := cfg.meterProvider.Meter(instrumentationName)
+
+	// instruments creation fails only on invalid names, no-op instruments are used then
+	duration, _ := meter.Float64Histogram("slack.client.duration",
+		metric.WithDescription("Duration of slack api calls"),
+		metric.WithUnit("s"),
+	)
+	errorsCounter, _ := meter.Int64Counter("slack.client.errors",
+		metric.WithDescription("Failed slack api calls by error code"),
+	)
+	rateLimited, _ := meter.Int64Counter("slack.client.rate_limited",
+		metric.WithDescription("Slack api calls responded with 429 status code"),
+	)
+
+	inst := &instruments{
+		tracer:      tracer,
+		duration:    duration,
+		errors:      errorsCounter,
+		rateLimited: rateLimited,
+		teamID:      cfg.teamID,
+	}
+
+	return inst.middleware
+}
+
+func (i *instruments) middleware(next slack.RoundTrip) slack.RoundTrip {
+	return func(ctx context.Context, req *slack.Request) (*http.Response, error) {
+		attrs := []attribute.KeyValue{AttrMethod.String(req.Method)}
+
+		request := requestEnvelope(req)
+		if teamID := firstNonEmpty(request.TeamID, req.Params.Get("team_id"), i.teamID); len(teamID) > 0 {
+			attrs = append(attrs, AttrTeam.String(teamID))
+		}
+
+		ctx, span := i.tracer.Start(ctx, "slack "+req.Method,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(attrs...),
+		)
+		defer span.End()
+
+		channel := firstNonEmpty(request.Channel, req.Params.Get("channel"), req.Params.Get("channel_id"))
+		if len(channel) > 0 {
+			span.SetAttributes(AttrChannel.String(channel))
+		}
+
+		span.SetAttributes(
+			AttrRetryCount.Int(req.Attempt-1),
+			AttrRateLimitWait.Int64(req.RateLimitWait.Milliseconds()),
+			AttrTokenType.String(string(req.TokenType)),
+		)
+
+		start := time.Now()
+		resp, err := next(ctx, req)
+		elapsed := time.Since(start).Seconds()
+
+		errorCode := ""
+		switch {
+		case err != nil:
+			errorCode = "request_failed"
+			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+				errorCode = "context_done"
+			}
+
+			span.RecordError(err)
+		case resp.StatusCode == http.StatusTooManyRequests:
+			errorCode = "ratelimited"
+			i.rateLimited.Add(ctx, 1, metric.WithAttributes(attrs...))
+		case resp.StatusCode != http.StatusOK:
+			errorCode = "http_" + strconv.Itoa(resp.StatusCode)
+		default:
+			errorCode = responseErrorCode(resp)
+		}
+
+		if resp != nil {
+			span.SetAttributes(AttrStatusCode.Int(resp.StatusCode))
+		}
+
+		if len(errorCode) > 0 {
+			span.SetAttributes(AttrErrorCode.String(errorCode))
+			span.SetStatus(codes.Error, errorCode)
+			i.errors.Add(ctx, 1, metric.WithAttributes(append(attrs, AttrErrorCode.String(errorCode))...))
+		}
+
+		i.duration.Record(ctx, elapsed, metric.WithAttributes(attrs...))
+
+		return resp, err
+	}
+}
+
+// requestEnvelope reads team and channel of json request bodies
+func requestEnvelope(req *slack.Request) envelope {
+	var env envelope
+	if len(req.Body) > 0 {
+		_ = json.Unmarshal(req.Body, &env)
+	}
+
+	return env
+}
+
+// responseErrorCode reads slack error code of json responses and restores the body
+func responseErrorCode(resp *http.Response) string {
+	body, err := slack.PeekJSONBody(resp)
+	if err != nil || len(body) == 0 {
+		return ""
+	}
+
+	var env envelope
+	if json.Unmarshal(body, &env) != nil || env.Ok {
+		return ""
+	}
+
+	return env.Error
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, val := range values {
+		if len(val) > 0 {
+			return val
+		}
+	}
+
+	return ""
+}
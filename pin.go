@@ -0,0 +1,127 @@
+// Package slack - pins
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+type (
+	// Pin entity
+	Pin struct {
+		// Type type of the pinned item, message for pinned messages
+		Type string `json:"type"`
+
+		// Channel where the item is pinned
+		Channel string `json:"channel"`
+
+		// Created a unix timestamp when the item was pinned
+		Created int64 `json:"created"`
+
+		// CreatedBy user who pinned the item
+		CreatedBy string `json:"created_by"`
+
+		// Message pinned message, set only when type is message
+		Message *PinnedMessage `json:"message,omitempty"`
+	}
+
+	// PinnedMessage pinned message entity
+	PinnedMessage struct {
+		// Type always message
+		Type string `json:"type"`
+
+		// Text message text
+		Text string `json:"text"`
+
+		// User author of the message
+		User string `json:"user"`
+
+		// BotID id of bot if message was posted by a bot
+		BotID string `json:"bot_id"`
+
+		// Team workspace of the author
+		Team string `json:"team"`
+
+		// EnterpriseID Enterprise Grid organization of the author, empty outside of Enterprise Grid
+		EnterpriseID string `json:"enterprise_id"`
+
+		// UserTeam workspace of the author's account, differs from Team for shared channels
+		UserTeam string `json:"user_team"`
+
+		// SourceTeam workspace the message was posted from
+		SourceTeam string `json:"source_team"`
+
+		// Timestamp ts value of the message
+		Timestamp string `json:"ts"`
+
+		// Permalink permanent url of the message
+		Permalink string `json:"permalink"`
+
+		// PinnedTo list of channels where the message is pinned
+		PinnedTo []string `json:"pinned_to"`
+	}
+
+	pinApiResponse struct {
+		// Ok indicates success or failure
+		Ok bool `json:"ok"`
+
+		// Error short machine-readable error code
+		Error string `json:"error"`
+
+		// Items pinned items
+		Items []Pin `json:"items"`
+	}
+)
+
+func addPin(ctx context.Context, c *client, msg MessageRef) error {
+	return changePin(ctx, c, "pins.add", msg)
+}
+
+func removePin(ctx context.Context, c *client, msg MessageRef) error {
+	return changePin(ctx, c, "pins.remove", msg)
+}
+
+func changePin(ctx context.Context, c *client, method string, msg MessageRef) error {
+	data, err := json.Marshal(msg.itemParams())
+	if err != nil {
+		return fmt.Errorf("can't marshal request: %w", err)
+	}
+
+	respBody, err := c.post(ctx, method, data)
+	if err != nil {
+		return err
+	}
+
+	_, err = decodePinResponse(respBody)
+
+	return err
+}
+
+func listPins(ctx context.Context, c *client, channel string) ([]Pin, error) {
+	respBody, err := c.get(ctx, "pins.list?"+url.Values{"channel": {channel}}.Encode())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := decodePinResponse(respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Items, nil
+}
+
+func decodePinResponse(respBody []byte) (pinApiResponse, error) {
+	var resp pinApiResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return pinApiResponse{}, fmt.Errorf("can't unmarshal response: %w", err)
+	}
+
+	if !resp.Ok {
+		return pinApiResponse{}, fmt.Errorf("slack respond with error: %s", resp.Error)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,100 @@
+package slack_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-slack"
+)
+
+func TestClient_AddPin(t *testing.T) {
+	baseUrl := "http://test.slack.com/api"
+
+	httpClient := new(slack.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, baseUrl+"/"+"pins.add", req.URL.String())
+
+		request, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"channel":"C1","timestamp":"1.2"}`, string(request))
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":true}`))),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	c := slack.NewClient("test_token", slack.WithBaseUrl(baseUrl), slack.WithHttpClient(httpClient))
+
+	posted := slack.MessagePosted{Channel: "C1", Timestamp: "1.2"}
+	assert.NoError(t, c.AddPin(context.Background(), posted.Ref()))
+}
+
+func TestClient_RemovePin(t *testing.T) {
+	httpClient := new(slack.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":false,"error":"no_pin"}`))),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	c := slack.NewClient("test_token", slack.WithHttpClient(httpClient))
+
+	err := c.RemovePin(context.Background(), slack.MessageRef{Channel: "C1", Timestamp: "1.2"})
+	assert.Error(t, err)
+	assert.Equal(t, "slack respond with error: no_pin", err.Error())
+}
+
+func TestClient_ListPins(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		baseUrl := "http://test.slack.com/api"
+
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Equal(t, baseUrl+"/"+"pins.list?channel=C1", req.URL.String())
+		}).Return(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":true,"items":[{"type":"message","channel":"C1",` +
+				`"created":1600000000,"created_by":"U1","message":{"text":"runbook","ts":"1.2",` +
+				`"team":"T1","enterprise_id":"E1","user_team":"T2","source_team":"T1"}}]}`))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		c := slack.NewClient("test_token", slack.WithBaseUrl(baseUrl), slack.WithHttpClient(httpClient))
+
+		pins, err := c.ListPins(context.Background(), "C1")
+		assert.NoError(t, err)
+		assert.Equal(t, []slack.Pin{{
+			Type:      "message",
+			Channel:   "C1",
+			Created:   1600000000,
+			CreatedBy: "U1",
+			Message: &slack.PinnedMessage{Text: "runbook", Timestamp: "1.2", Team: "T1", EnterpriseID: "E1",
+				UserTeam: "T2", SourceTeam: "T1"},
+		}}, pins)
+	})
+
+	t.Run("error on unmarshal response", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("{"))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		c := slack.NewClient("test_token", slack.WithHttpClient(httpClient))
+
+		pins, err := c.ListPins(context.Background(), "C1")
+		assert.Error(t, err)
+		assert.Nil(t, pins)
+	})
+}
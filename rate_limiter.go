@@ -0,0 +1,78 @@
+// Package slack - rate limiting
+package slack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const defaultRetryAfter = time.Second
+
+type (
+	// RateLimiter holds rate limit state of a token. When slack responds with 429 status code all further requests
+	// made with the limiter wait until the Retry-After delay is over. Safe for concurrent use, can be shared by
+	// several clients using the same token
+	RateLimiter struct {
+		mu    sync.Mutex
+		until time.Time
+	}
+
+	// RateLimitedError slack respond with 429 status code
+	RateLimitedError struct {
+		// RetryAfter delay before the next request
+		RetryAfter time.Duration
+	}
+)
+
+// NewRateLimiter is rate limiter constructor
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{}
+}
+
+// Error implementation
+func (e *RateLimitedError) Error() string {
+	return fmt.Sprintf("slack respond with %d status code, retry after %s", http.StatusTooManyRequests, e.RetryAfter)
+}
+
+// Wait blocks until requests are allowed or the context is done
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	delay := time.Until(l.until)
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (l *RateLimiter) block(retryAfter time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if until := time.Now().Add(retryAfter); until.After(l.until) {
+		l.until = until
+	}
+}
+
+func retryAfter(header http.Header) time.Duration {
+	seconds, err := strconv.Atoi(header.Get("Retry-After"))
+	if err != nil || seconds <= 0 {
+		return defaultRetryAfter
+	}
+
+	return time.Duration(seconds) * time.Second
+}
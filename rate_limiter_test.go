@@ -0,0 +1,42 @@
+package slack_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-slack"
+)
+
+func TestRateLimiter(t *testing.T) {
+	httpClient := new(slack.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+		Header:     http.Header{"Retry-After": {"30"}},
+		Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		StatusCode: http.StatusTooManyRequests,
+	}, nil).Once()
+
+	limiter := slack.NewRateLimiter()
+	first := slack.NewClient("test_token", slack.WithHttpClient(httpClient), slack.WithRateLimiter(limiter))
+	second := slack.NewClient("test_token", slack.WithHttpClient(httpClient), slack.WithRateLimiter(limiter))
+
+	_, err := first.SendRequest(context.Background(), http.MethodGet, "users.list", nil)
+
+	var rateLimited *slack.RateLimitedError
+	assert.True(t, errors.As(err, &rateLimited))
+	assert.Equal(t, 30*time.Second, rateLimited.RetryAfter)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = second.SendRequest(ctx, http.MethodGet, "users.list", nil)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	httpClient.AssertNumberOfCalls(t, "Do", 1)
+}
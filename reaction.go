@@ -0,0 +1,228 @@
+// Package slack - reactions
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+type (
+	// ItemRef references an item which can have reactions: a message or a file
+	ItemRef interface {
+		itemParams() map[string]string
+	}
+
+	// MessageRef references a message by its channel and timestamp
+	MessageRef struct {
+		// Channel where the message was posted
+		Channel string
+
+		// Timestamp ts value of the message
+		Timestamp string
+	}
+
+	// FileRef references a file by its id
+	FileRef struct {
+		// ID file identifier
+		ID string
+	}
+
+	// Reaction entity
+	Reaction struct {
+		// Name emoji name without colons
+		Name string `json:"name"`
+
+		// Count number of users reacted with the emoji
+		Count int `json:"count"`
+
+		// Users list of users reacted with the emoji. Can be incomplete for popular reactions
+		Users []string `json:"users"`
+	}
+
+	// ReactedItem an item with its reactions
+	ReactedItem struct {
+		// Type type of the item: message or file
+		Type string `json:"type"`
+
+		// Channel channel of the message, set only for messages
+		Channel string `json:"channel"`
+
+		// Message reacted message, set only when type is message
+		Message *ReactedMessage `json:"message,omitempty"`
+
+		// File reacted file, set only when type is file
+		File *ReactedFile `json:"file,omitempty"`
+	}
+
+	// ReactedMessage message with its reactions
+	ReactedMessage struct {
+		// Type always message
+		Type string `json:"type"`
+
+		// Text message text
+		Text string `json:"text"`
+
+		// User author of the message
+		User string `json:"user"`
+
+		// BotID id of bot if message was posted by a bot
+		BotID string `json:"bot_id"`
+
+		// Team workspace of the author
+		Team string `json:"team"`
+
+		// EnterpriseID Enterprise Grid organization of the author, empty outside of Enterprise Grid
+		EnterpriseID string `json:"enterprise_id"`
+
+		// UserTeam workspace of the author's account, differs from Team for shared channels
+		UserTeam string `json:"user_team"`
+
+		// SourceTeam workspace the message was posted from
+		SourceTeam string `json:"source_team"`
+
+		// Timestamp ts value of the message
+		Timestamp string `json:"ts"`
+
+		// Reactions list of reactions
+		Reactions []Reaction `json:"reactions"`
+	}
+
+	// ReactedFile file with its reactions
+	ReactedFile struct {
+		// ID file identifier
+		ID string `json:"id"`
+
+		// Name file name
+		Name string `json:"name"`
+
+		// Title file title
+		Title string `json:"title"`
+
+		// Team workspace the file belongs to
+		Team string `json:"team"`
+
+		// EnterpriseID Enterprise Grid organization of the file, empty outside of Enterprise Grid
+		EnterpriseID string `json:"enterprise_id"`
+
+		// UserTeam workspace of the user who uploaded the file
+		UserTeam string `json:"user_team"`
+
+		// SourceTeam workspace the file was uploaded to
+		SourceTeam string `json:"source_team"`
+
+		// Reactions list of reactions
+		Reactions []Reaction `json:"reactions"`
+	}
+
+	// ReactedItems page of items reacted by a user
+	ReactedItems struct {
+		// Items reacted items
+		Items []ReactedItem `json:"items"`
+
+		// ResponseMetadata pagination information
+		ResponseMetadata ResponseMetadata `json:"response_metadata"`
+	}
+
+	reactionApiResponse struct {
+		// Ok indicates success or failure
+		Ok bool `json:"ok"`
+
+		// Error short machine-readable error code
+		Error string `json:"error"`
+
+		ReactedItem
+
+		ReactedItems
+	}
+)
+
+// Ref returns reference to the posted message
+func (m MessagePosted) Ref() MessageRef {
+	return MessageRef{Channel: m.Channel, Timestamp: m.Timestamp}
+}
+
+func (r MessageRef) itemParams() map[string]string {
+	return map[string]string{"channel": r.Channel, "timestamp": r.Timestamp}
+}
+
+func (r FileRef) itemParams() map[string]string {
+	return map[string]string{"file": r.ID}
+}
+
+func addReaction(ctx context.Context, c *client, name string, msg MessageRef) error {
+	return changeReaction(ctx, c, "reactions.add", name, msg)
+}
+
+func removeReaction(ctx context.Context, c *client, name string, item ItemRef) error {
+	return changeReaction(ctx, c, "reactions.remove", name, item)
+}
+
+func changeReaction(ctx context.Context, c *client, method, name string, item ItemRef) error {
+	params := item.itemParams()
+	params["name"] = name
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		return fmt.Errorf("can't marshal request: %w", err)
+	}
+
+	respBody, err := c.post(ctx, method, data)
+	if err != nil {
+		return err
+	}
+
+	_, err = decodeReactionResponse(respBody)
+
+	return err
+}
+
+func getReactions(ctx context.Context, c *client, item ItemRef) (ReactedItem, error) {
+	params := url.Values{"full": {"true"}}
+	for key, val := range item.itemParams() {
+		params.Set(key, val)
+	}
+
+	respBody, err := c.get(ctx, "reactions.get?"+params.Encode())
+	if err != nil {
+		return ReactedItem{}, err
+	}
+
+	resp, err := decodeReactionResponse(respBody)
+	if err != nil {
+		return ReactedItem{}, err
+	}
+
+	return resp.ReactedItem, nil
+}
+
+func listReactions(ctx context.Context, c *client, opts ...ListOption) (ReactedItems, error) {
+	params := listParams(opts)
+	params.Set("full", "true")
+
+	respBody, err := c.get(ctx, "reactions.list?"+params.Encode())
+	if err != nil {
+		return ReactedItems{}, err
+	}
+
+	resp, err := decodeReactionResponse(respBody)
+	if err != nil {
+		return ReactedItems{}, err
+	}
+
+	return resp.ReactedItems, nil
+}
+
+func decodeReactionResponse(respBody []byte) (reactionApiResponse, error) {
+	var resp reactionApiResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return reactionApiResponse{}, fmt.Errorf("can't unmarshal response: %w", err)
+	}
+
+	if !resp.Ok {
+		return reactionApiResponse{}, fmt.Errorf("slack respond with error: %s", resp.Error)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,182 @@
+package slack_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-slack"
+)
+
+func TestClient_AddReaction(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		baseUrl := "http://test.slack.com/api"
+
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, baseUrl+"/"+"reactions.add", req.URL.String())
+
+			request, err := ioutil.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"channel":"C1","timestamp":"1.2","name":"eyes"}`, string(request))
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":true}`))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		c := slack.NewClient("test_token", slack.WithBaseUrl(baseUrl), slack.WithHttpClient(httpClient))
+
+		posted := slack.MessagePosted{Channel: "C1", Timestamp: "1.2"}
+		assert.NoError(t, c.AddReaction(context.Background(), "eyes", posted.Ref()))
+	})
+
+	t.Run("slack respond with error", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":false,"error":"already_reacted"}`))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		c := slack.NewClient("test_token", slack.WithHttpClient(httpClient))
+
+		err := c.AddReaction(context.Background(), "eyes", slack.MessageRef{Channel: "C1", Timestamp: "1.2"})
+		assert.Error(t, err)
+		assert.Equal(t, "slack respond with error: already_reacted", err.Error())
+	})
+
+	t.Run("error on sending request", func(t *testing.T) {
+		expErr := errors.New("test error")
+
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(nil, expErr)
+
+		c := slack.NewClient("test_token", slack.WithHttpClient(httpClient))
+
+		err := c.AddReaction(context.Background(), "eyes", slack.MessageRef{Channel: "C1", Timestamp: "1.2"})
+		assert.True(t, errors.Is(err, expErr))
+	})
+}
+
+func TestClient_RemoveReaction(t *testing.T) {
+	baseUrl := "http://test.slack.com/api"
+
+	httpClient := new(slack.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, baseUrl+"/"+"reactions.remove", req.URL.String())
+
+		request, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"file":"F1","name":"eyes"}`, string(request))
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":true}`))),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	c := slack.NewClient("test_token", slack.WithBaseUrl(baseUrl), slack.WithHttpClient(httpClient))
+
+	assert.NoError(t, c.RemoveReaction(context.Background(), "eyes", slack.FileRef{ID: "F1"}))
+}
+
+func TestClient_GetReactions(t *testing.T) {
+	t.Run("message", func(t *testing.T) {
+		baseUrl := "http://test.slack.com/api"
+
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Equal(t, baseUrl+"/"+"reactions.get?channel=C1&full=true&timestamp=1.2", req.URL.String())
+		}).Return(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":true,"type":"message","channel":"C1",` +
+				`"message":{"type":"message","text":"hi","ts":"1.2",` +
+				`"reactions":[{"name":"eyes","count":1,"users":["U1"]}]}}`))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		c := slack.NewClient("test_token", slack.WithBaseUrl(baseUrl), slack.WithHttpClient(httpClient))
+
+		item, err := c.GetReactions(context.Background(), slack.MessageRef{Channel: "C1", Timestamp: "1.2"})
+		assert.NoError(t, err)
+		assert.Equal(t, slack.ReactedItem{
+			Type:    "message",
+			Channel: "C1",
+			Message: &slack.ReactedMessage{
+				Type:      "message",
+				Text:      "hi",
+				Timestamp: "1.2",
+				Reactions: []slack.Reaction{{Name: "eyes", Count: 1, Users: []string{"U1"}}},
+			},
+		}, item)
+	})
+
+	t.Run("file", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":true,"type":"file",` +
+				`"file":{"id":"F1","reactions":[{"name":"tada","count":2}]}}`))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		c := slack.NewClient("test_token", slack.WithHttpClient(httpClient))
+
+		item, err := c.GetReactions(context.Background(), slack.FileRef{ID: "F1"})
+		assert.NoError(t, err)
+		assert.Equal(t, "F1", item.File.ID)
+		assert.Equal(t, []slack.Reaction{{Name: "tada", Count: 2}}, item.File.Reactions)
+	})
+
+	t.Run("error on unmarshal response", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("{"))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		c := slack.NewClient("test_token", slack.WithHttpClient(httpClient))
+
+		item, err := c.GetReactions(context.Background(), slack.FileRef{ID: "F1"})
+		assert.Error(t, err)
+		assert.Equal(t, slack.ReactedItem{}, item)
+	})
+}
+
+func TestClient_ListReactions(t *testing.T) {
+	baseUrl := "http://test.slack.com/api"
+
+	httpClient := new(slack.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, baseUrl+"/"+"reactions.list?cursor=abc&full=true&limit=10&user=U1", req.URL.String())
+	}).Return(&http.Response{
+		Body: ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":true,` +
+			`"items":[{"type":"message","channel":"C1","message":{"ts":"1.2"}},{"type":"file","file":{"id":"F1"}}],` +
+			`"response_metadata":{"next_cursor":"def"}}`))),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	c := slack.NewClient("test_token", slack.WithBaseUrl(baseUrl), slack.WithHttpClient(httpClient))
+
+	items, err := c.ListReactions(context.Background(), slack.ByUser("U1"), slack.Cursor("abc"), slack.Limit(10))
+	assert.NoError(t, err)
+	assert.Len(t, items.Items, 2)
+	assert.Equal(t, "1.2", items.Items[0].Message.Timestamp)
+	assert.Equal(t, "F1", items.Items[1].File.ID)
+	assert.Equal(t, "def", items.ResponseMetadata.NextCursor)
+}
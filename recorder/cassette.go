@@ -0,0 +1,98 @@
+// Package recorder - recording and replaying of http interactions with slack
+package recorder
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+type (
+	// Cassette recorded http interactions
+	Cassette struct {
+		// Interactions request and response pairs in order of sending
+		Interactions []Interaction `json:"interactions"`
+	}
+
+	// Interaction recorded request and response pair
+	Interaction struct {
+		// Request recorded request
+		Request Request `json:"request"`
+
+		// Response recorded response
+		Response Response `json:"response"`
+	}
+
+	// Request recorded request
+	Request struct {
+		// Method http method
+		Method string `json:"method"`
+
+		// URL request url
+		URL string `json:"url"`
+
+		// ContentType content type of the body
+		ContentType string `json:"content_type,omitempty"`
+
+		// Body request body
+		Body string `json:"body,omitempty"`
+	}
+
+	// Response recorded response
+	Response struct {
+		// StatusCode http status code
+		StatusCode int `json:"status_code"`
+
+		// Header response headers
+		Header map[string][]string `json:"header,omitempty"`
+
+		// Body response body
+		Body string `json:"body"`
+	}
+)
+
+// LoadCassette reads a cassette file
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("can't read cassette: %w", err)
+	}
+
+	var cassette Cassette
+	if err = json.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("can't unmarshal cassette %s: %w", path, err)
+	}
+
+	return &cassette, nil
+}
+
+// Save writes the cassette file, replacing it atomically
+func (c *Cassette) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("can't marshal cassette: %w", err)
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("can't create temp file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("can't write cassette: %w", err)
+	}
+
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("can't write cassette: %w", err)
+	}
+
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("can't replace cassette: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+// Package recorder - options
+package recorder
+
+import "regexp"
+
+type (
+	// Option to use optional parameters in recorder and replayer
+	Option interface {
+		apply(cfg *config)
+	}
+
+	config struct {
+		fields   []string
+		patterns []*regexp.Regexp
+	}
+
+	withRedactedFields struct {
+		fields []string
+	}
+
+	withRedactedPattern struct {
+		pattern *regexp.Regexp
+	}
+)
+
+// WithRedactedFields replaces json fields and form parameters to redact, DefaultRedactedFields by default
+func WithRedactedFields(fields ...string) Option {
+	return &withRedactedFields{fields: fields}
+}
+
+func (opt *withRedactedFields) apply(cfg *config) {
+	cfg.fields = opt.fields
+}
+
+// WithRedactedPattern adds a pattern to redact in urls and bodies, tokens and emails are always redacted
+func WithRedactedPattern(pattern *regexp.Regexp) Option {
+	return &withRedactedPattern{pattern: pattern}
+}
+
+func (opt *withRedactedPattern) apply(cfg *config) {
+	cfg.patterns = append(cfg.patterns, opt.pattern)
+}
+
+func newConfig(opts []Option) config {
+	cfg := config{fields: DefaultRedactedFields}
+	for _, opt := range opts {
+		opt.apply(&cfg)
+	}
+
+	return cfg
+}
@@ -0,0 +1,107 @@
+// Package recorder - recording http client
+package recorder
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	"github.com/kryabinin/go-slack"
+)
+
+type (
+	// Recorder http client sending requests with the wrapped client and recording redacted interactions. Call Save
+	// to write the cassette file
+	Recorder struct {
+		next     slack.HTTPClient
+		path     string
+		redactor *redactor
+
+		mu       sync.Mutex
+		cassette Cassette
+	}
+)
+
+// NewRecorder is recorder constructor, the cassette is saved to the path
+func NewRecorder(path string, next slack.HTTPClient, opts ...Option) *Recorder {
+	cfg := newConfig(opts)
+
+	return &Recorder{
+		next:     next,
+		path:     path,
+		redactor: newRedactor(cfg.fields, cfg.patterns),
+	}
+}
+
+// Do implementation
+func (r *Recorder) Do(req *http.Request) (*http.Response, error) {
+	reqBody, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.next.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("can't read response body: %w", err)
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: r.redactor.request(req, reqBody),
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     r.redactor.header(resp.Header),
+			Body:       r.redactor.body(resp.Header.Get("Content-Type"), string(respBody)),
+		},
+	})
+
+	return resp, nil
+}
+
+// Save writes recorded interactions to the cassette file
+func (r *Recorder) Save() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.cassette.Save(r.path)
+}
+
+// readBody reads the request body and restores it for sending
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("can't read request body: %w", err)
+	}
+
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+func (r *redactor) request(req *http.Request, body []byte) Request {
+	contentType := req.Header.Get("Content-Type")
+
+	return Request{
+		Method:      req.Method,
+		URL:         r.url(req.URL.String()),
+		ContentType: contentType,
+		Body:        r.body(contentType, string(body)),
+	}
+}
@@ -0,0 +1,194 @@
+// Package recorder - redaction of secrets and personal data
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/kryabinin/go-slack"
+)
+
+const (
+	// Redacted replacement of redacted values
+	Redacted = slack.Redacted
+
+	formContentType = "application/x-www-form-urlencoded"
+)
+
+var (
+	// DefaultRedactedFields json fields and form parameters redacted by default
+	DefaultRedactedFields = []string{
+		"token", "client_secret", "refresh_token", "access_token", "code",
+		"email", "phone", "real_name", "real_name_normalized", "display_name", "display_name_normalized",
+		"first_name", "last_name", "skype",
+	}
+
+	// redactedHeaders headers carrying credentials, their values are always redacted
+	redactedHeaders = map[string]bool{
+		"Authorization": true,
+		"Cookie":        true,
+		"Set-Cookie":    true,
+	}
+)
+
+type redactor struct {
+	fields   map[string]bool
+	patterns []*regexp.Regexp
+}
+
+func newRedactor(fields []string, patterns []*regexp.Regexp) *redactor {
+	r := &redactor{
+		fields:   make(map[string]bool),
+		patterns: append([]*regexp.Regexp{slack.TokenPattern, slack.EmailPattern}, patterns...),
+	}
+
+	for _, field := range fields {
+		r.fields[field] = true
+	}
+
+	return r
+}
+
+// body redacts fields of json and form bodies and patterns in the rest. Json bodies keep their original bytes except
+// the redacted values
+func (r *redactor) body(contentType, body string) string {
+	if redacted, ok := r.json(body); ok {
+		return r.text(redacted)
+	}
+
+	if strings.HasPrefix(contentType, formContentType) {
+		if form, err := url.ParseQuery(body); err == nil {
+			return r.text(r.form(form).Encode())
+		}
+	}
+
+	return r.text(body)
+}
+
+// header redacts credential headers and patterns in values of the rest
+func (r *redactor) header(header http.Header) map[string][]string {
+	redacted := make(map[string][]string, len(header))
+	for key, values := range header {
+		redacted[key] = make([]string, len(values))
+		for i, value := range values {
+			if redactedHeaders[http.CanonicalHeaderKey(key)] {
+				redacted[key][i] = Redacted
+				continue
+			}
+
+			redacted[key][i] = r.text(value)
+		}
+	}
+
+	return redacted
+}
+
+// url redacts query parameters and patterns of the url
+func (r *redactor) url(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return r.text(rawURL)
+	}
+
+	u.RawQuery = r.form(u.Query()).Encode()
+
+	return r.text(u.String())
+}
+
+// json replaces string values of redacted fields in place, reports false if the body is not json
+func (r *redactor) json(body string) (string, bool) {
+	type frame struct {
+		object    bool
+		expectKey bool
+	}
+
+	dec := json.NewDecoder(strings.NewReader(body))
+	dec.UseNumber()
+
+	var (
+		out      bytes.Buffer
+		stack    []*frame
+		last     int
+		redact   bool
+		keyEnd   int
+		complete bool
+	)
+
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil || complete {
+			return "", false
+		}
+
+		var top *frame
+		if len(stack) > 0 {
+			top = stack[len(stack)-1]
+		}
+
+		switch tok {
+		case json.Delim('{'), json.Delim('['):
+			stack = append(stack, &frame{object: tok == json.Delim('{'), expectKey: tok == json.Delim('{')})
+			redact = false
+			continue
+		case json.Delim('}'), json.Delim(']'):
+			stack = stack[:len(stack)-1]
+		default:
+			if top != nil && top.object && top.expectKey {
+				key, _ := tok.(string)
+				redact, keyEnd, top.expectKey = r.fields[key], int(dec.InputOffset()), false
+				continue
+			}
+
+			if _, ok := tok.(string); ok && redact {
+				// the value is the first string after the key, only a colon and spaces are between them
+				start := keyEnd + strings.IndexByte(body[keyEnd:], '"')
+				out.WriteString(body[last:start])
+				out.WriteString(`"` + Redacted + `"`)
+				last = int(dec.InputOffset())
+			}
+
+			redact = false
+		}
+
+		if len(stack) == 0 {
+			complete = true
+		} else if parent := stack[len(stack)-1]; parent.object {
+			parent.expectKey = true
+		}
+	}
+
+	if !complete {
+		return "", false
+	}
+
+	out.WriteString(body[last:])
+
+	return out.String(), true
+}
+
+func (r *redactor) form(form url.Values) url.Values {
+	for key := range form {
+		if r.fields[key] {
+			form.Set(key, Redacted)
+		}
+	}
+
+	return form
+}
+
+func (r *redactor) text(text string) string {
+	for _, pattern := range r.patterns {
+		text = pattern.ReplaceAllString(text, Redacted)
+	}
+
+	return text
+}
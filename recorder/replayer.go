@@ -0,0 +1,145 @@
+// Package recorder - replaying http client
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// ErrUnmatched the request doesn't match any recorded interaction
+var ErrUnmatched = errors.New("no recorded interaction matches the request")
+
+type (
+	// Replayer http client responding with recorded interactions without network. Requests are matched by method,
+	// path with query and normalized body after redaction. Each interaction is replayed once, in order of recording
+	Replayer struct {
+		redactor *redactor
+
+		mu       sync.Mutex
+		cassette *Cassette
+		used     []bool
+	}
+)
+
+// NewReplayer is replayer constructor loading the cassette from the path. Use the same options as for recording
+func NewReplayer(path string, opts ...Option) (*Replayer, error) {
+	cassette, err := LoadCassette(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := newConfig(opts)
+
+	return &Replayer{
+		redactor: newRedactor(cfg.fields, cfg.patterns),
+		cassette: cassette,
+		used:     make([]bool, len(cassette.Interactions)),
+	}, nil
+}
+
+// Do implementation
+func (r *Replayer) Do(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	recorded := r.redactor.request(req, body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || !matches(interaction.Request, recorded) {
+			continue
+		}
+
+		r.used[i] = true
+
+		header := make(http.Header, len(interaction.Response.Header))
+		for key, values := range interaction.Response.Header {
+			header[key] = values
+		}
+
+		return &http.Response{
+			StatusCode: interaction.Response.StatusCode,
+			Header:     header,
+			Body:       ioutil.NopCloser(strings.NewReader(interaction.Response.Body)),
+			Request:    req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s %s %s", ErrUnmatched, recorded.Method, recorded.URL, recorded.Body)
+}
+
+// Unused returns recorded interactions which were not replayed
+func (r *Replayer) Unused() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var unused []Interaction
+	for i, interaction := range r.cassette.Interactions {
+		if !r.used[i] {
+			unused = append(unused, interaction)
+		}
+	}
+
+	return unused
+}
+
+func matches(recorded, req Request) bool {
+	return recorded.Method == req.Method &&
+		requestPath(recorded.URL) == requestPath(req.URL) &&
+		normalizeBody(recorded.ContentType, recorded.Body) == normalizeBody(req.ContentType, req.Body)
+}
+
+// requestPath returns path with sorted query of the url ignoring scheme and host
+func requestPath(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+
+	return strings.TrimRight(u.Path, "/") + "?" + u.Query().Encode()
+}
+
+// normalizeBody sorts form parameters and json object keys, recorded bodies keep the original order
+func normalizeBody(contentType, body string) string {
+	if normalized, ok := normalizeJSON(body); ok {
+		return normalized
+	}
+
+	if strings.HasPrefix(contentType, formContentType) {
+		if form, err := url.ParseQuery(body); err == nil {
+			return form.Encode()
+		}
+	}
+
+	return body
+}
+
+func normalizeJSON(body string) (string, bool) {
+	dec := json.NewDecoder(strings.NewReader(body))
+	dec.UseNumber()
+
+	var data interface{}
+	if err := dec.Decode(&data); err != nil || dec.More() {
+		return "", false
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(data); err != nil {
+		return "", false
+	}
+
+	return buf.String(), true
+}
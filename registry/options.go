@@ -0,0 +1,54 @@
+// Package registry - registry options
+package registry
+
+import (
+	"github.com/kryabinin/go-slack"
+)
+
+type (
+	// Option to use optional parameters in registry
+	Option interface {
+		apply(r *Registry)
+	}
+
+	withHttpClient struct {
+		httpClient slack.HTTPClient
+	}
+
+	withClientOptions struct {
+		opts []slack.ClientOption
+	}
+
+	withTokenRotation struct {
+		clientID     string
+		clientSecret string
+	}
+)
+
+// WithHttpClient replaces default http client shared by all clients
+func WithHttpClient(httpClient slack.HTTPClient) Option {
+	return &withHttpClient{httpClient: httpClient}
+}
+
+func (opt *withHttpClient) apply(r *Registry) {
+	r.httpClient = opt.httpClient
+}
+
+// WithClientOptions adds options applied to every built client
+func WithClientOptions(opts ...slack.ClientOption) Option {
+	return &withClientOptions{opts: opts}
+}
+
+func (opt *withClientOptions) apply(r *Registry) {
+	r.clientOpts = append(r.clientOpts, opt.opts...)
+}
+
+// WithTokenRotation refreshes rotating tokens of installations and saves refreshed tokens to the store
+func WithTokenRotation(clientID, clientSecret string) Option {
+	return &withTokenRotation{clientID: clientID, clientSecret: clientSecret}
+}
+
+func (opt *withTokenRotation) apply(r *Registry) {
+	r.clientID = opt.clientID
+	r.clientSecret = opt.clientSecret
+}
@@ -0,0 +1,300 @@
+// Package registry - slack clients of multiple workspaces
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/kryabinin/go-slack"
+	"github.com/kryabinin/go-slack/oauth"
+)
+
+var revokedErrors = map[string]bool{
+	"account_inactive": true,
+	"token_revoked":    true,
+}
+
+type (
+	// ClientProvider provides slack clients of workspaces
+	ClientProvider interface {
+		// Client returns client of a workspace or an Enterprise Grid organization. The enterprise id can be empty for
+		// workspaces outside of Enterprise Grid
+		Client(ctx context.Context, enterpriseID, teamID string) (slack.Client, error)
+	}
+
+	// Registry lazily builds clients from installations. Clients of the same installation share the token source and
+	// rate limit state, clients of an organization wide installation are scoped to their workspace, see slack.ForTeam.
+	// Installations are evicted when slack responds with account_inactive or token_revoked error. Safe for concurrent
+	// use
+	Registry struct {
+		store        oauth.InstallationStore
+		httpClient   slack.HTTPClient
+		clientOpts   []slack.ClientOption
+		clientID     string
+		clientSecret string
+
+		mu        sync.Mutex
+		clients   map[teamKey]slack.Client
+		owners    map[teamKey]teamKey
+		pending   map[teamKey]*pendingClient
+		installed map[teamKey]slack.Client
+		building  map[teamKey]*pendingClient
+		evictions int
+		evicted   map[teamKey]int
+	}
+
+	// pendingClient client being built, concurrent callers of the same key wait for it instead of building their own
+	pendingClient struct {
+		done   chan struct{}
+		client slack.Client
+		err    error
+	}
+
+	teamKey struct {
+		enterpriseID string
+		teamID       string
+	}
+)
+
+// New is registry constructor
+func New(store oauth.InstallationStore, opts ...Option) *Registry {
+	r := &Registry{
+		store:      store,
+		httpClient: &http.Client{},
+		clients:    make(map[teamKey]slack.Client),
+		owners:     make(map[teamKey]teamKey),
+		pending:    make(map[teamKey]*pendingClient),
+		installed:  make(map[teamKey]slack.Client),
+		building:   make(map[teamKey]*pendingClient),
+		evicted:    make(map[teamKey]int),
+	}
+
+	for _, opt := range opts {
+		opt.apply(r)
+	}
+
+	return r
+}
+
+// Client implementation
+func (r *Registry) Client(ctx context.Context, enterpriseID, teamID string) (slack.Client, error) {
+	key := teamKey{enterpriseID: enterpriseID, teamID: teamID}
+
+	r.mu.Lock()
+	if c, ok := r.clients[key]; ok {
+		r.mu.Unlock()
+		return c, nil
+	}
+
+	if p, ok := r.pending[key]; ok {
+		r.mu.Unlock()
+
+		select {
+		case <-p.done:
+			return p.client, p.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	p := &pendingClient{done: make(chan struct{})}
+	r.pending[key] = p
+	r.mu.Unlock()
+
+	// the store and the client are accessed without the lock, errors are not cached
+	p.client, p.err = r.newClient(ctx, key)
+
+	r.mu.Lock()
+	delete(r.pending, key)
+	r.mu.Unlock()
+	close(p.done)
+
+	return p.client, p.err
+}
+
+func (r *Registry) newClient(ctx context.Context, key teamKey) (slack.Client, error) {
+	for {
+		r.mu.Lock()
+		evictions := r.evictions
+		r.mu.Unlock()
+
+		installation, err := r.store.Find(ctx, key.enterpriseID, key.teamID)
+		if err != nil {
+			return nil, fmt.Errorf("can't find installation: %w", err)
+		}
+
+		owner := installationKey(installation)
+
+		c, err := r.installationClient(ctx, owner, installation, evictions)
+		if err != nil {
+			return nil, err
+		}
+
+		if owner != key && len(key.teamID) > 0 {
+			c = slack.ForTeam(c, key.teamID)
+		}
+
+		r.mu.Lock()
+		if r.evicted[owner] > evictions {
+			// the installation was evicted while the client was built, it's looked up again
+			r.mu.Unlock()
+			continue
+		}
+
+		r.clients[key] = c
+		r.owners[key] = owner
+		r.mu.Unlock()
+
+		return c, nil
+	}
+}
+
+// installationClient returns the client of the installation, it's built once for all workspaces of an organization
+// wide installation, so they share the single-use refresh token
+func (r *Registry) installationClient(
+	ctx context.Context,
+	owner teamKey,
+	installation oauth.Installation,
+	evictions int,
+) (slack.Client, error) {
+	r.mu.Lock()
+	if c, ok := r.installed[owner]; ok {
+		r.mu.Unlock()
+		return c, nil
+	}
+
+	if p, ok := r.building[owner]; ok {
+		r.mu.Unlock()
+
+		select {
+		case <-p.done:
+			return p.client, p.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	p := &pendingClient{done: make(chan struct{})}
+	r.building[owner] = p
+	r.mu.Unlock()
+
+	p.client = r.buildClient(owner, installation)
+
+	r.mu.Lock()
+	delete(r.building, owner)
+	if r.evicted[owner] <= evictions {
+		r.installed[owner] = p.client
+	}
+	r.mu.Unlock()
+	close(p.done)
+
+	return p.client, nil
+}
+
+func (r *Registry) buildClient(owner teamKey, installation oauth.Installation) slack.Client {
+	opts := append([]slack.ClientOption{
+		slack.WithHttpClient(r.httpClient),
+		slack.WithRateLimiter(slack.NewRateLimiter()),
+		slack.WithErrorHook(func(ctx context.Context, code string) {
+			if revokedErrors[code] {
+				_ = r.Evict(ctx, owner.enterpriseID, owner.teamID)
+			}
+		}),
+	}, r.clientOpts...)
+
+	token := installation.BotToken
+	if len(token) == 0 {
+		token = installation.UserToken
+	}
+
+	if len(r.clientID) > 0 && len(tokenOf(installation).RefreshToken) > 0 {
+		// refresh requests share the base url, the middlewares and the http client of the workspace clients
+		sourceOpts := append([]slack.ClientOption{slack.WithHttpClient(r.httpClient)}, r.clientOpts...)
+
+		opts = append(opts, slack.WithTokenSource(slack.NewRotatingTokenSource(
+			r.clientID,
+			r.clientSecret,
+			tokenOf(installation),
+			r.persistToken(owner),
+			sourceOpts...,
+		)))
+	}
+
+	return slack.NewClient(token, opts...)
+}
+
+// Evict drops clients of the installation and deletes it from the store
+func (r *Registry) Evict(ctx context.Context, enterpriseID, teamID string) error {
+	owner := teamKey{enterpriseID: enterpriseID, teamID: teamID}
+
+	r.mu.Lock()
+	for key, keyOwner := range r.owners {
+		if keyOwner == owner {
+			delete(r.clients, key)
+			delete(r.owners, key)
+		}
+	}
+	delete(r.installed, owner)
+	r.evictions++
+	r.evicted[owner] = r.evictions
+	r.mu.Unlock()
+
+	if err := r.store.Delete(ctx, enterpriseID, teamID); err != nil {
+		return fmt.Errorf("can't delete installation: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Registry) persistToken(owner teamKey) func(ctx context.Context, token slack.RotatingToken) error {
+	return func(ctx context.Context, token slack.RotatingToken) error {
+		installation, err := r.store.Find(ctx, owner.enterpriseID, owner.teamID)
+		if errors.Is(err, oauth.ErrInstallationNotFound) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(installation.BotToken) > 0 {
+			installation.BotToken = token.AccessToken
+			installation.BotRefreshToken = token.RefreshToken
+			installation.BotTokenExpiresAt = token.ExpiresAt
+		} else {
+			installation.UserToken = token.AccessToken
+			installation.UserRefreshToken = token.RefreshToken
+			installation.UserTokenExpiresAt = token.ExpiresAt
+		}
+
+		return r.store.Save(ctx, installation)
+	}
+}
+
+func installationKey(installation oauth.Installation) teamKey {
+	key := teamKey{enterpriseID: installation.EnterpriseID, teamID: installation.TeamID}
+	if installation.IsEnterpriseInstall {
+		key.teamID = ""
+	}
+
+	return key
+}
+
+func tokenOf(installation oauth.Installation) slack.RotatingToken {
+	if len(installation.BotToken) > 0 {
+		return slack.RotatingToken{
+			AccessToken:  installation.BotToken,
+			RefreshToken: installation.BotRefreshToken,
+			ExpiresAt:    installation.BotTokenExpiresAt,
+		}
+	}
+
+	return slack.RotatingToken{
+		AccessToken:  installation.UserToken,
+		RefreshToken: installation.UserRefreshToken,
+		ExpiresAt:    installation.UserTokenExpiresAt,
+	}
+}
@@ -0,0 +1,291 @@
+package registry_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-slack"
+	"github.com/kryabinin/go-slack/oauth"
+	"github.com/kryabinin/go-slack/registry"
+)
+
+func TestRegistry_Client(t *testing.T) {
+	ctx := context.Background()
+	baseUrl := "http://test.slack.com/api"
+
+	t.Run("clients are built lazily and cached", func(t *testing.T) {
+		store := oauth.NewMemoryStore()
+		assert.NoError(t, store.Save(ctx, oauth.Installation{TeamID: "T1", BotToken: "xoxb-1"}))
+		assert.NoError(t, store.Save(ctx, oauth.Installation{TeamID: "T2", BotToken: "xoxb-2"}))
+
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, "Bearer xoxb-2", req.Header.Get("Authorization"))
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":true}`))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		r := registry.New(store, registry.WithHttpClient(httpClient))
+
+		first, err := r.Client(ctx, "", "T2")
+		assert.NoError(t, err)
+
+		second, err := r.Client(ctx, "", "T2")
+		assert.NoError(t, err)
+		assert.True(t, first == second)
+
+		_, err = first.SendRequest(ctx, http.MethodPost, "auth.test", nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("concurrent callers share the client", func(t *testing.T) {
+		store := &blockingStore{InstallationStore: oauth.NewMemoryStore(), release: make(chan struct{})}
+		assert.NoError(t, store.Save(ctx, oauth.Installation{TeamID: "T1", BotToken: "xoxb-1"}))
+
+		r := registry.New(store)
+
+		var wg sync.WaitGroup
+		clients := make([]slack.Client, 3)
+		for i := range clients {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+
+				c, err := r.Client(ctx, "", "T1")
+				assert.NoError(t, err)
+				clients[i] = c
+			}(i)
+		}
+
+		// other workspaces are not blocked by the pending lookup
+		assert.NoError(t, store.Save(ctx, oauth.Installation{TeamID: "T2", BotToken: "xoxb-2"}))
+		_, err := r.Client(ctx, "", "T2")
+		assert.NoError(t, err)
+
+		close(store.release)
+		wg.Wait()
+
+		assert.Equal(t, int32(2), atomic.LoadInt32(&store.finds))
+		assert.True(t, clients[0] == clients[1] && clients[1] == clients[2])
+	})
+
+	t.Run("installation not found", func(t *testing.T) {
+		r := registry.New(oauth.NewMemoryStore())
+
+		c, err := r.Client(ctx, "", "T1")
+		assert.True(t, errors.Is(err, oauth.ErrInstallationNotFound))
+		assert.Nil(t, c)
+	})
+
+	t.Run("organization clients share rate limit state", func(t *testing.T) {
+		store := oauth.NewMemoryStore()
+		assert.NoError(t, store.Save(ctx, oauth.Installation{
+			EnterpriseID:        "E1",
+			IsEnterpriseInstall: true,
+			BotToken:            "xoxb-org",
+		}))
+
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Header:     http.Header{"Retry-After": {"30"}},
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+			StatusCode: http.StatusTooManyRequests,
+		}, nil).Once()
+
+		r := registry.New(store, registry.WithHttpClient(httpClient))
+
+		first, err := r.Client(ctx, "E1", "T1")
+		assert.NoError(t, err)
+
+		second, err := r.Client(ctx, "E1", "T2")
+		assert.NoError(t, err)
+		assert.False(t, first == second)
+
+		_, err = first.SendRequest(ctx, http.MethodPost, "chat.postMessage", nil)
+		assert.Error(t, err)
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+
+		_, err = second.SendRequest(timeoutCtx, http.MethodPost, "chat.postMessage", nil)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		httpClient.AssertNumberOfCalls(t, "Do", 1)
+	})
+
+	t.Run("revoked installation is evicted", func(t *testing.T) {
+		store := oauth.NewMemoryStore()
+		assert.NoError(t, store.Save(ctx, oauth.Installation{TeamID: "T1", BotToken: "xoxb-1"}))
+
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":false,"error":"token_revoked"}`))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		r := registry.New(store, registry.WithHttpClient(httpClient))
+
+		c, err := r.Client(ctx, "", "T1")
+		assert.NoError(t, err)
+
+		_, err = c.PostMessage(ctx, "hi", "C1")
+		assert.NoError(t, err)
+
+		_, err = store.Find(ctx, "", "T1")
+		assert.True(t, errors.Is(err, oauth.ErrInstallationNotFound))
+
+		_, err = r.Client(ctx, "", "T1")
+		assert.True(t, errors.Is(err, oauth.ErrInstallationNotFound))
+	})
+
+	t.Run("rotated tokens are saved to the store", func(t *testing.T) {
+		store := oauth.NewMemoryStore()
+		assert.NoError(t, store.Save(ctx, oauth.Installation{
+			TeamID:            "T1",
+			BotToken:          "xoxe.xoxb-old",
+			BotRefreshToken:   "xoxe-old",
+			BotTokenExpiresAt: time.Now(),
+		}))
+
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+			return req.URL.String() == baseUrl+"/oauth.v2.access"
+		})).Return(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewReader([]byte(
+				`{"ok":true,"access_token":"xoxe.xoxb-new","refresh_token":"xoxe-new","expires_in":43200}`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, "Bearer xoxe.xoxb-new", req.Header.Get("Authorization"))
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":true}`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		r := registry.New(
+			store,
+			registry.WithHttpClient(httpClient),
+			registry.WithTokenRotation("id", "secret"),
+			registry.WithClientOptions(slack.WithBaseUrl(baseUrl)),
+		)
+
+		c, err := r.Client(ctx, "", "T1")
+		assert.NoError(t, err)
+
+		_, err = c.SendRequest(ctx, http.MethodPost, "auth.test", nil)
+		assert.NoError(t, err)
+
+		installation, err := store.Find(ctx, "", "T1")
+		assert.NoError(t, err)
+		assert.Equal(t, "xoxe.xoxb-new", installation.BotToken)
+		assert.Equal(t, "xoxe-new", installation.BotRefreshToken)
+	})
+
+	t.Run("organization workspaces share the rotating token", func(t *testing.T) {
+		store := oauth.NewMemoryStore()
+		assert.NoError(t, store.Save(ctx, oauth.Installation{
+			EnterpriseID:        "E1",
+			IsEnterpriseInstall: true,
+			BotToken:            "xoxe.xoxb-old",
+			BotRefreshToken:     "xoxe-old",
+			BotTokenExpiresAt:   time.Now(),
+		}))
+
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+			return req.URL.String() == baseUrl+"/oauth.v2.access"
+		})).Return(&http.Response{
+			Body: ioutil.NopCloser(bytes.NewReader([]byte(
+				`{"ok":true,"access_token":"xoxe.xoxb-new","refresh_token":"xoxe-new","expires_in":43200}`))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		var teams []string
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, "Bearer xoxe.xoxb-new", req.Header.Get("Authorization"))
+			teams = append(teams, req.URL.Query().Get("team_id"))
+		}).Return(func(*http.Request) *http.Response {
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":true}`))),
+				StatusCode: http.StatusOK,
+			}
+		}, nil).Twice()
+
+		r := registry.New(
+			store,
+			registry.WithHttpClient(httpClient),
+			registry.WithTokenRotation("id", "secret"),
+			registry.WithClientOptions(slack.WithBaseUrl(baseUrl)),
+		)
+
+		for _, teamID := range []string{"T1", "T2"} {
+			c, err := r.Client(ctx, "E1", teamID)
+			assert.NoError(t, err)
+
+			_, err = c.SendRequest(ctx, http.MethodGet, "conversations.list", nil)
+			assert.NoError(t, err)
+		}
+
+		assert.Equal(t, []string{"T1", "T2"}, teams)
+		httpClient.AssertNumberOfCalls(t, "Do", 3)
+	})
+
+	t.Run("client built during eviction is not cached", func(t *testing.T) {
+		store := &blockingStore{InstallationStore: oauth.NewMemoryStore(), release: make(chan struct{})}
+		assert.NoError(t, store.Save(ctx, oauth.Installation{TeamID: "T1", BotToken: "xoxb-1"}))
+
+		r := registry.New(store)
+
+		done := make(chan error, 1)
+		go func() {
+			_, err := r.Client(ctx, "", "T1")
+			done <- err
+		}()
+
+		for atomic.LoadInt32(&store.finds) == 0 {
+			time.Sleep(time.Millisecond)
+		}
+
+		assert.NoError(t, r.Evict(ctx, "", "T1"))
+		close(store.release)
+
+		assert.True(t, errors.Is(<-done, oauth.ErrInstallationNotFound))
+	})
+}
+
+type blockingStore struct {
+	oauth.InstallationStore
+	release chan struct{}
+	finds   int32
+}
+
+func (s *blockingStore) Find(ctx context.Context, enterpriseID, teamID string) (oauth.Installation, error) {
+	// the installation is found before blocking, so it can be evicted while the client is built
+	installation, err := s.InstallationStore.Find(ctx, enterpriseID, teamID)
+
+	atomic.AddInt32(&s.finds, 1)
+	if teamID == "T1" {
+		<-s.release
+	}
+
+	return installation, err
+}
@@ -0,0 +1,107 @@
+// Package signature - verification of requests sent by slack
+package signature
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// HeaderSignature header with the request signature
+	HeaderSignature = "X-Slack-Signature"
+
+	// HeaderTimestamp header with the request timestamp
+	HeaderTimestamp = "X-Slack-Request-Timestamp"
+
+	// DefaultMaxAge default maximum age of requests to protect from replay attacks
+	DefaultMaxAge = 5 * time.Minute
+
+	version        = "v0"
+	maxRequestSize = 4 << 20
+)
+
+var (
+	// ErrMissingHeaders the request doesn't have signature headers
+	ErrMissingHeaders = errors.New("missing signature headers")
+
+	// ErrExpired the request timestamp is out of the replay window
+	ErrExpired = errors.New("request timestamp is expired")
+
+	// ErrMismatch the request signature doesn't match the body
+	ErrMismatch = errors.New("request signature mismatch")
+)
+
+type (
+	// Verifier verifies signatures of requests with the app signing secret
+	Verifier struct {
+		secret string
+		maxAge time.Duration
+		now    func() time.Time
+	}
+)
+
+// NewVerifier is verifier constructor. Requests older than maxAge are rejected, DefaultMaxAge is used when it is zero
+func NewVerifier(secret string, maxAge time.Duration) *Verifier {
+	if maxAge == 0 {
+		maxAge = DefaultMaxAge
+	}
+
+	return &Verifier{secret: secret, maxAge: maxAge, now: time.Now}
+}
+
+// Sign calculates signature of the body sent at the timestamp
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(version + ":" + strconv.FormatInt(timestamp, 10) + ":"))
+	mac.Write(body)
+
+	return version + "=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify verifies signature headers of the body
+func (v *Verifier) Verify(header http.Header, body []byte) error {
+	sig, ts := header.Get(HeaderSignature), header.Get(HeaderTimestamp)
+	if len(sig) == 0 || len(ts) == 0 {
+		return ErrMissingHeaders
+	}
+
+	timestamp, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid timestamp %q: %w", ts, ErrMismatch)
+	}
+
+	age := v.now().Sub(time.Unix(timestamp, 0))
+	if age > v.maxAge || age < -v.maxAge {
+		return ErrExpired
+	}
+
+	if !hmac.Equal([]byte(Sign(v.secret, timestamp, body)), []byte(sig)) {
+		return ErrMismatch
+	}
+
+	return nil
+}
+
+// VerifyRequest reads body of the request and verifies its signature. The body is replaced, so it can be read again
+func (v *Verifier) VerifyRequest(r *http.Request) ([]byte, error) {
+	body, err := ioutil.ReadAll(http.MaxBytesReader(nil, r.Body, maxRequestSize))
+	if err != nil {
+		return nil, fmt.Errorf("can't read request body: %w", err)
+	}
+
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	if err = v.Verify(r.Header, body); err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
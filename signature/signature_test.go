@@ -0,0 +1,99 @@
+package signature_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kryabinin/go-slack/signature"
+)
+
+func TestVerifier_Verify(t *testing.T) {
+	var (
+		secret = "8f742231b10e8888abcd99yyyzzz85a5"
+		body   = []byte("token=xyzz0WbapA4vBCDEFasx0q6G&team_id=T1DC2JH3J&command=%2Fweather&text=94070")
+	)
+
+	header := func(ts int64, sig string) http.Header {
+		return http.Header{
+			signature.HeaderTimestamp: {strconv.FormatInt(ts, 10)},
+			signature.HeaderSignature: {sig},
+		}
+	}
+
+	now := time.Now().Unix()
+	testCases := []struct {
+		name   string
+		header http.Header
+		expErr error
+	}{
+		{
+			name:   "valid signature",
+			header: header(now, signature.Sign(secret, now, body)),
+		},
+		{
+			name:   "missing headers",
+			header: http.Header{},
+			expErr: signature.ErrMissingHeaders,
+		},
+		{
+			name:   "expired timestamp",
+			header: header(now-600, signature.Sign(secret, now-600, body)),
+			expErr: signature.ErrExpired,
+		},
+		{
+			name:   "timestamp from the future",
+			header: header(now+600, signature.Sign(secret, now+600, body)),
+			expErr: signature.ErrExpired,
+		},
+		{
+			name:   "wrong secret",
+			header: header(now, signature.Sign("other", now, body)),
+			expErr: signature.ErrMismatch,
+		},
+		{
+			name:   "wrong timestamp",
+			header: header(now-1, signature.Sign(secret, now, body)),
+			expErr: signature.ErrMismatch,
+		},
+	}
+
+	verifier := signature.NewVerifier(secret, 0)
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := verifier.Verify(testCase.header, body)
+			if testCase.expErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, errors.Is(err, testCase.expErr))
+			}
+		})
+	}
+}
+
+func TestVerifier_VerifyRequest(t *testing.T) {
+	var (
+		secret = "secret"
+		body   = []byte(`{"type":"url_verification"}`)
+		now    = time.Now().Unix()
+	)
+
+	req := httptest.NewRequest(http.MethodPost, "/slack/events", bytes.NewReader(body))
+	req.Header.Set(signature.HeaderTimestamp, strconv.FormatInt(now, 10))
+	req.Header.Set(signature.HeaderSignature, signature.Sign(secret, now, body))
+
+	verified, err := signature.NewVerifier(secret, time.Minute).VerifyRequest(req)
+	assert.NoError(t, err)
+	assert.Equal(t, body, verified)
+
+	again, err := ioutil.ReadAll(req.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, body, again)
+}
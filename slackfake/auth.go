@@ -0,0 +1,108 @@
+// Package slackfake - fake of slack.Auth
+package slackfake
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kryabinin/go-slack"
+)
+
+type (
+	// AuthTestCall arguments of a AuthTest call
+	AuthTestCall struct {
+	}
+
+	// TokenInfoCall arguments of a TokenInfo call
+	TokenInfoCall struct {
+	}
+
+	// ListAuthTeamsCall arguments of a ListAuthTeams call
+	ListAuthTeamsCall struct {
+		// Opts opts argument
+		Opts []slack.ListOption
+	}
+
+	// Auth fake of slack.Auth recording calls. Methods return results of the funcs when they are set,
+	// zero values otherwise
+	Auth struct {
+		// AuthTestFunc returns result of AuthTest
+		AuthTestFunc func(ctx context.Context) (slack.AuthInfo, error)
+
+		// TokenInfoFunc returns result of TokenInfo
+		TokenInfoFunc func(ctx context.Context) (slack.TokenInfo, error)
+
+		// ListAuthTeamsFunc returns result of ListAuthTeams
+		ListAuthTeamsFunc func(ctx context.Context, opts ...slack.ListOption) (slack.TeamsList, error)
+
+		mu                 sync.Mutex
+		authTestCalls      []AuthTestCall
+		tokenInfoCalls     []TokenInfoCall
+		listAuthTeamsCalls []ListAuthTeamsCall
+	}
+)
+
+var _ slack.Auth = (*Auth)(nil)
+
+// AuthTest implementation
+func (f *Auth) AuthTest(ctx context.Context) (slack.AuthInfo, error) {
+	f.mu.Lock()
+	f.authTestCalls = append(f.authTestCalls, AuthTestCall{})
+	f.mu.Unlock()
+
+	if f.AuthTestFunc != nil {
+		return f.AuthTestFunc(ctx)
+	}
+
+	return slack.AuthInfo{}, nil
+}
+
+// AuthTestCalls returns arguments of AuthTest calls
+func (f *Auth) AuthTestCalls() []AuthTestCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]AuthTestCall(nil), f.authTestCalls...)
+}
+
+// TokenInfo implementation
+func (f *Auth) TokenInfo(ctx context.Context) (slack.TokenInfo, error) {
+	f.mu.Lock()
+	f.tokenInfoCalls = append(f.tokenInfoCalls, TokenInfoCall{})
+	f.mu.Unlock()
+
+	if f.TokenInfoFunc != nil {
+		return f.TokenInfoFunc(ctx)
+	}
+
+	return slack.TokenInfo{}, nil
+}
+
+// TokenInfoCalls returns arguments of TokenInfo calls
+func (f *Auth) TokenInfoCalls() []TokenInfoCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]TokenInfoCall(nil), f.tokenInfoCalls...)
+}
+
+// ListAuthTeams implementation
+func (f *Auth) ListAuthTeams(ctx context.Context, opts ...slack.ListOption) (slack.TeamsList, error) {
+	f.mu.Lock()
+	f.listAuthTeamsCalls = append(f.listAuthTeamsCalls, ListAuthTeamsCall{Opts: opts})
+	f.mu.Unlock()
+
+	if f.ListAuthTeamsFunc != nil {
+		return f.ListAuthTeamsFunc(ctx, opts...)
+	}
+
+	return slack.TeamsList{}, nil
+}
+
+// ListAuthTeamsCalls returns arguments of ListAuthTeams calls
+func (f *Auth) ListAuthTeamsCalls() []ListAuthTeamsCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]ListAuthTeamsCall(nil), f.listAuthTeamsCalls...)
+}
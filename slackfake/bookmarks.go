@@ -0,0 +1,161 @@
+// Package slackfake - fake of slack.Bookmarks
+package slackfake
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kryabinin/go-slack"
+)
+
+type (
+	// AddBookmarkCall arguments of a AddBookmark call
+	AddBookmarkCall struct {
+		// Channel channel argument
+		Channel string
+
+		// Title title argument
+		Title string
+
+		// Link link argument
+		Link string
+
+		// Opts opts argument
+		Opts []slack.BookmarkOption
+	}
+
+	// EditBookmarkCall arguments of a EditBookmark call
+	EditBookmarkCall struct {
+		// Channel channel argument
+		Channel string
+
+		// BookmarkID bookmarkID argument
+		BookmarkID string
+
+		// Opts opts argument
+		Opts []slack.BookmarkOption
+	}
+
+	// RemoveBookmarkCall arguments of a RemoveBookmark call
+	RemoveBookmarkCall struct {
+		// Channel channel argument
+		Channel string
+
+		// BookmarkID bookmarkID argument
+		BookmarkID string
+	}
+
+	// ListBookmarksCall arguments of a ListBookmarks call
+	ListBookmarksCall struct {
+		// Channel channel argument
+		Channel string
+	}
+
+	// Bookmarks fake of slack.Bookmarks recording calls. Methods return results of the funcs when they are set,
+	// zero values otherwise
+	Bookmarks struct {
+		// AddBookmarkFunc returns result of AddBookmark
+		AddBookmarkFunc func(ctx context.Context, channel string, title string, link string, opts ...slack.BookmarkOption) (slack.Bookmark, error)
+
+		// EditBookmarkFunc returns result of EditBookmark
+		EditBookmarkFunc func(ctx context.Context, channel string, bookmarkID string, opts ...slack.BookmarkOption) (slack.Bookmark, error)
+
+		// RemoveBookmarkFunc returns result of RemoveBookmark
+		RemoveBookmarkFunc func(ctx context.Context, channel string, bookmarkID string) error
+
+		// ListBookmarksFunc returns result of ListBookmarks
+		ListBookmarksFunc func(ctx context.Context, channel string) ([]slack.Bookmark, error)
+
+		mu                  sync.Mutex
+		addBookmarkCalls    []AddBookmarkCall
+		editBookmarkCalls   []EditBookmarkCall
+		removeBookmarkCalls []RemoveBookmarkCall
+		listBookmarksCalls  []ListBookmarksCall
+	}
+)
+
+var _ slack.Bookmarks = (*Bookmarks)(nil)
+
+// AddBookmark implementation
+func (f *Bookmarks) AddBookmark(ctx context.Context, channel string, title string, link string, opts ...slack.BookmarkOption) (slack.Bookmark, error) {
+	f.mu.Lock()
+	f.addBookmarkCalls = append(f.addBookmarkCalls, AddBookmarkCall{Channel: channel, Title: title, Link: link, Opts: opts})
+	f.mu.Unlock()
+
+	if f.AddBookmarkFunc != nil {
+		return f.AddBookmarkFunc(ctx, channel, title, link, opts...)
+	}
+
+	return slack.Bookmark{}, nil
+}
+
+// AddBookmarkCalls returns arguments of AddBookmark calls
+func (f *Bookmarks) AddBookmarkCalls() []AddBookmarkCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]AddBookmarkCall(nil), f.addBookmarkCalls...)
+}
+
+// EditBookmark implementation
+func (f *Bookmarks) EditBookmark(ctx context.Context, channel string, bookmarkID string, opts ...slack.BookmarkOption) (slack.Bookmark, error) {
+	f.mu.Lock()
+	f.editBookmarkCalls = append(f.editBookmarkCalls, EditBookmarkCall{Channel: channel, BookmarkID: bookmarkID, Opts: opts})
+	f.mu.Unlock()
+
+	if f.EditBookmarkFunc != nil {
+		return f.EditBookmarkFunc(ctx, channel, bookmarkID, opts...)
+	}
+
+	return slack.Bookmark{}, nil
+}
+
+// EditBookmarkCalls returns arguments of EditBookmark calls
+func (f *Bookmarks) EditBookmarkCalls() []EditBookmarkCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]EditBookmarkCall(nil), f.editBookmarkCalls...)
+}
+
+// RemoveBookmark implementation
+func (f *Bookmarks) RemoveBookmark(ctx context.Context, channel string, bookmarkID string) error {
+	f.mu.Lock()
+	f.removeBookmarkCalls = append(f.removeBookmarkCalls, RemoveBookmarkCall{Channel: channel, BookmarkID: bookmarkID})
+	f.mu.Unlock()
+
+	if f.RemoveBookmarkFunc != nil {
+		return f.RemoveBookmarkFunc(ctx, channel, bookmarkID)
+	}
+
+	return nil
+}
+
+// RemoveBookmarkCalls returns arguments of RemoveBookmark calls
+func (f *Bookmarks) RemoveBookmarkCalls() []RemoveBookmarkCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]RemoveBookmarkCall(nil), f.removeBookmarkCalls...)
+}
+
+// ListBookmarks implementation
+func (f *Bookmarks) ListBookmarks(ctx context.Context, channel string) ([]slack.Bookmark, error) {
+	f.mu.Lock()
+	f.listBookmarksCalls = append(f.listBookmarksCalls, ListBookmarksCall{Channel: channel})
+	f.mu.Unlock()
+
+	if f.ListBookmarksFunc != nil {
+		return f.ListBookmarksFunc(ctx, channel)
+	}
+
+	return nil, nil
+}
+
+// ListBookmarksCalls returns arguments of ListBookmarks calls
+func (f *Bookmarks) ListBookmarksCalls() []ListBookmarksCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]ListBookmarksCall(nil), f.listBookmarksCalls...)
+}
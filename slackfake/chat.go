@@ -0,0 +1,56 @@
+// Package slackfake - fake of slack.Chat
+package slackfake
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kryabinin/go-slack"
+)
+
+type (
+	// PostMessageCall arguments of a PostMessage call
+	PostMessageCall struct {
+		// Message message argument
+		Message string
+
+		// Channel channel argument
+		Channel string
+
+		// Opts opts argument
+		Opts []slack.MsgOption
+	}
+
+	// Chat fake of slack.Chat recording calls. Methods return results of the funcs when they are set,
+	// zero values otherwise
+	Chat struct {
+		// PostMessageFunc returns result of PostMessage
+		PostMessageFunc func(ctx context.Context, message string, channel string, opts ...slack.MsgOption) (slack.MessagePosted, error)
+
+		mu               sync.Mutex
+		postMessageCalls []PostMessageCall
+	}
+)
+
+var _ slack.Chat = (*Chat)(nil)
+
+// PostMessage implementation
+func (f *Chat) PostMessage(ctx context.Context, message string, channel string, opts ...slack.MsgOption) (slack.MessagePosted, error) {
+	f.mu.Lock()
+	f.postMessageCalls = append(f.postMessageCalls, PostMessageCall{Message: message, Channel: channel, Opts: opts})
+	f.mu.Unlock()
+
+	if f.PostMessageFunc != nil {
+		return f.PostMessageFunc(ctx, message, channel, opts...)
+	}
+
+	return slack.MessagePosted{}, nil
+}
+
+// PostMessageCalls returns arguments of PostMessage calls
+func (f *Chat) PostMessageCalls() []PostMessageCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]PostMessageCall(nil), f.postMessageCalls...)
+}
@@ -0,0 +1,100 @@
+// Package slackfake - typed fakes of slack client interfaces for tests of code depending on them. Unlike MockClient
+// they don't need expectations: set result funcs of the methods used by the code and check recorded calls
+package slackfake
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kryabinin/go-slack"
+)
+
+type (
+	// SendRequestCall arguments of a SendRequest call
+	SendRequestCall struct {
+		// Method http method
+		Method string
+
+		// Path api method path
+		Path string
+
+		// Data request body
+		Data []byte
+	}
+
+	// SendFormRequestCall arguments of a SendFormRequest call
+	SendFormRequestCall struct {
+		// Path api method path
+		Path string
+
+		// Data form encoded request body
+		Data []byte
+	}
+
+	// Client fake of slack.Client composed of the domain fakes
+	Client struct {
+		Chat
+		Users
+		Reactions
+		Pins
+		Bookmarks
+		Files
+		Views
+		Auth
+		Teams
+
+		// SendRequestFunc returns result of SendRequest
+		SendRequestFunc func(ctx context.Context, method string, path string, data []byte) ([]byte, error)
+
+		// SendFormRequestFunc returns result of SendFormRequest
+		SendFormRequestFunc func(ctx context.Context, path string, data []byte) ([]byte, error)
+
+		mu                   sync.Mutex
+		sendRequestCalls     []SendRequestCall
+		sendFormRequestCalls []SendFormRequestCall
+	}
+)
+
+var _ slack.Client = (*Client)(nil)
+
+// SendRequest implementation
+func (f *Client) SendRequest(ctx context.Context, method string, path string, data []byte) ([]byte, error) {
+	f.mu.Lock()
+	f.sendRequestCalls = append(f.sendRequestCalls, SendRequestCall{Method: method, Path: path, Data: data})
+	f.mu.Unlock()
+
+	if f.SendRequestFunc != nil {
+		return f.SendRequestFunc(ctx, method, path, data)
+	}
+
+	return nil, nil
+}
+
+// SendRequestCalls returns arguments of SendRequest calls
+func (f *Client) SendRequestCalls() []SendRequestCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]SendRequestCall(nil), f.sendRequestCalls...)
+}
+
+// SendFormRequest implementation
+func (f *Client) SendFormRequest(ctx context.Context, path string, data []byte) ([]byte, error) {
+	f.mu.Lock()
+	f.sendFormRequestCalls = append(f.sendFormRequestCalls, SendFormRequestCall{Path: path, Data: data})
+	f.mu.Unlock()
+
+	if f.SendFormRequestFunc != nil {
+		return f.SendFormRequestFunc(ctx, path, data)
+	}
+
+	return nil, nil
+}
+
+// SendFormRequestCalls returns arguments of SendFormRequest calls
+func (f *Client) SendFormRequestCalls() []SendFormRequestCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]SendFormRequestCall(nil), f.sendFormRequestCalls...)
+}
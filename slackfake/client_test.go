@@ -0,0 +1,78 @@
+package slackfake_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kryabinin/go-slack"
+	"github.com/kryabinin/go-slack/slackfake"
+)
+
+// notifier depends only on the part of the client it uses
+type notifier struct {
+	chat  slack.Chat
+	users slack.Users
+}
+
+func (n *notifier) notify(ctx context.Context, email, text string) error {
+	user, err := n.users.GetUserByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
+
+	_, err = n.chat.PostMessage(ctx, text, user.ID)
+
+	return err
+}
+
+func TestFakes(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("domain fakes", func(t *testing.T) {
+		users := &slackfake.Users{
+			GetUserByEmailFunc: func(ctx context.Context, email string) (slack.User, error) {
+				return slack.User{ID: "U1"}, nil
+			},
+		}
+		chat := &slackfake.Chat{}
+
+		n := &notifier{chat: chat, users: users}
+		assert.NoError(t, n.notify(ctx, "john@example.com", "hello"))
+
+		assert.Equal(t, []slackfake.GetUserByEmailCall{{Email: "john@example.com"}}, users.GetUserByEmailCalls())
+		assert.Equal(t, []slackfake.PostMessageCall{{Message: "hello", Channel: "U1"}}, chat.PostMessageCalls())
+	})
+
+	t.Run("client fake", func(t *testing.T) {
+		expErr := errors.New("test error")
+
+		c := &slackfake.Client{}
+		c.GetUserByEmailFunc = func(ctx context.Context, email string) (slack.User, error) {
+			return slack.User{}, expErr
+		}
+
+		var client slack.Client = c
+
+		n := &notifier{chat: client, users: client}
+		assert.Equal(t, expErr, n.notify(ctx, "john@example.com", "hello"))
+
+		assert.Len(t, c.GetUserByEmailCalls(), 1)
+		assert.Empty(t, c.PostMessageCalls())
+	})
+
+	t.Run("zero results", func(t *testing.T) {
+		c := &slackfake.Client{}
+
+		view, err := c.OpenView(ctx, "tr1", slack.View{Type: slack.ViewModal})
+		assert.NoError(t, err)
+		assert.Equal(t, slack.View{}, view)
+		assert.Equal(t, []slackfake.OpenViewCall{{TriggerID: "tr1", View: slack.View{Type: slack.ViewModal}}},
+			c.OpenViewCalls())
+
+		assert.NoError(t, c.AddPin(ctx, slack.MessageRef{Channel: "C1", Timestamp: "1.2"}))
+		assert.Len(t, c.AddPinCalls(), 1)
+	})
+}
@@ -0,0 +1,243 @@
+// Package slackfake - fake of slack.Files
+package slackfake
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/kryabinin/go-slack"
+)
+
+type (
+	// UploadFileCall arguments of a UploadFile call
+	UploadFileCall struct {
+		// R r argument
+		R io.Reader
+
+		// Opts opts argument
+		Opts []slack.UploadOption
+	}
+
+	// GetFileInfoCall arguments of a GetFileInfo call
+	GetFileInfoCall struct {
+		// FileID fileID argument
+		FileID string
+	}
+
+	// ListFilesCall arguments of a ListFiles call
+	ListFilesCall struct {
+		// Opts opts argument
+		Opts []slack.ListOption
+	}
+
+	// DeleteFileCall arguments of a DeleteFile call
+	DeleteFileCall struct {
+		// FileID fileID argument
+		FileID string
+	}
+
+	// ShareFilePublicURLCall arguments of a ShareFilePublicURL call
+	ShareFilePublicURLCall struct {
+		// FileID fileID argument
+		FileID string
+	}
+
+	// RevokeFilePublicURLCall arguments of a RevokeFilePublicURL call
+	RevokeFilePublicURLCall struct {
+		// FileID fileID argument
+		FileID string
+	}
+
+	// DownloadFileCall arguments of a DownloadFile call
+	DownloadFileCall struct {
+		// File file argument
+		File slack.File
+
+		// W w argument
+		W io.Writer
+	}
+
+	// Files fake of slack.Files recording calls. Methods return results of the funcs when they are set,
+	// zero values otherwise
+	Files struct {
+		// UploadFileFunc returns result of UploadFile
+		UploadFileFunc func(ctx context.Context, r io.Reader, opts ...slack.UploadOption) ([]slack.File, error)
+
+		// GetFileInfoFunc returns result of GetFileInfo
+		GetFileInfoFunc func(ctx context.Context, fileID string) (slack.File, error)
+
+		// ListFilesFunc returns result of ListFiles
+		ListFilesFunc func(ctx context.Context, opts ...slack.ListOption) (slack.FilesList, error)
+
+		// DeleteFileFunc returns result of DeleteFile
+		DeleteFileFunc func(ctx context.Context, fileID string) error
+
+		// ShareFilePublicURLFunc returns result of ShareFilePublicURL
+		ShareFilePublicURLFunc func(ctx context.Context, fileID string) (slack.File, error)
+
+		// RevokeFilePublicURLFunc returns result of RevokeFilePublicURL
+		RevokeFilePublicURLFunc func(ctx context.Context, fileID string) (slack.File, error)
+
+		// DownloadFileFunc returns result of DownloadFile
+		DownloadFileFunc func(ctx context.Context, file slack.File, w io.Writer) error
+
+		mu                       sync.Mutex
+		uploadFileCalls          []UploadFileCall
+		getFileInfoCalls         []GetFileInfoCall
+		listFilesCalls           []ListFilesCall
+		deleteFileCalls          []DeleteFileCall
+		shareFilePublicURLCalls  []ShareFilePublicURLCall
+		revokeFilePublicURLCalls []RevokeFilePublicURLCall
+		downloadFileCalls        []DownloadFileCall
+	}
+)
+
+var _ slack.Files = (*Files)(nil)
+
+// UploadFile implementation
+func (f *Files) UploadFile(ctx context.Context, r io.Reader, opts ...slack.UploadOption) ([]slack.File, error) {
+	f.mu.Lock()
+	f.uploadFileCalls = append(f.uploadFileCalls, UploadFileCall{R: r, Opts: opts})
+	f.mu.Unlock()
+
+	if f.UploadFileFunc != nil {
+		return f.UploadFileFunc(ctx, r, opts...)
+	}
+
+	return nil, nil
+}
+
+// UploadFileCalls returns arguments of UploadFile calls
+func (f *Files) UploadFileCalls() []UploadFileCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]UploadFileCall(nil), f.uploadFileCalls...)
+}
+
+// GetFileInfo implementation
+func (f *Files) GetFileInfo(ctx context.Context, fileID string) (slack.File, error) {
+	f.mu.Lock()
+	f.getFileInfoCalls = append(f.getFileInfoCalls, GetFileInfoCall{FileID: fileID})
+	f.mu.Unlock()
+
+	if f.GetFileInfoFunc != nil {
+		return f.GetFileInfoFunc(ctx, fileID)
+	}
+
+	return slack.File{}, nil
+}
+
+// GetFileInfoCalls returns arguments of GetFileInfo calls
+func (f *Files) GetFileInfoCalls() []GetFileInfoCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]GetFileInfoCall(nil), f.getFileInfoCalls...)
+}
+
+// ListFiles implementation
+func (f *Files) ListFiles(ctx context.Context, opts ...slack.ListOption) (slack.FilesList, error) {
+	f.mu.Lock()
+	f.listFilesCalls = append(f.listFilesCalls, ListFilesCall{Opts: opts})
+	f.mu.Unlock()
+
+	if f.ListFilesFunc != nil {
+		return f.ListFilesFunc(ctx, opts...)
+	}
+
+	return slack.FilesList{}, nil
+}
+
+// ListFilesCalls returns arguments of ListFiles calls
+func (f *Files) ListFilesCalls() []ListFilesCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]ListFilesCall(nil), f.listFilesCalls...)
+}
+
+// DeleteFile implementation
+func (f *Files) DeleteFile(ctx context.Context, fileID string) error {
+	f.mu.Lock()
+	f.deleteFileCalls = append(f.deleteFileCalls, DeleteFileCall{FileID: fileID})
+	f.mu.Unlock()
+
+	if f.DeleteFileFunc != nil {
+		return f.DeleteFileFunc(ctx, fileID)
+	}
+
+	return nil
+}
+
+// DeleteFileCalls returns arguments of DeleteFile calls
+func (f *Files) DeleteFileCalls() []DeleteFileCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]DeleteFileCall(nil), f.deleteFileCalls...)
+}
+
+// ShareFilePublicURL implementation
+func (f *Files) ShareFilePublicURL(ctx context.Context, fileID string) (slack.File, error) {
+	f.mu.Lock()
+	f.shareFilePublicURLCalls = append(f.shareFilePublicURLCalls, ShareFilePublicURLCall{FileID: fileID})
+	f.mu.Unlock()
+
+	if f.ShareFilePublicURLFunc != nil {
+		return f.ShareFilePublicURLFunc(ctx, fileID)
+	}
+
+	return slack.File{}, nil
+}
+
+// ShareFilePublicURLCalls returns arguments of ShareFilePublicURL calls
+func (f *Files) ShareFilePublicURLCalls() []ShareFilePublicURLCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]ShareFilePublicURLCall(nil), f.shareFilePublicURLCalls...)
+}
+
+// RevokeFilePublicURL implementation
+func (f *Files) RevokeFilePublicURL(ctx context.Context, fileID string) (slack.File, error) {
+	f.mu.Lock()
+	f.revokeFilePublicURLCalls = append(f.revokeFilePublicURLCalls, RevokeFilePublicURLCall{FileID: fileID})
+	f.mu.Unlock()
+
+	if f.RevokeFilePublicURLFunc != nil {
+		return f.RevokeFilePublicURLFunc(ctx, fileID)
+	}
+
+	return slack.File{}, nil
+}
+
+// RevokeFilePublicURLCalls returns arguments of RevokeFilePublicURL calls
+func (f *Files) RevokeFilePublicURLCalls() []RevokeFilePublicURLCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]RevokeFilePublicURLCall(nil), f.revokeFilePublicURLCalls...)
+}
+
+// DownloadFile implementation
+func (f *Files) DownloadFile(ctx context.Context, file slack.File, w io.Writer) error {
+	f.mu.Lock()
+	f.downloadFileCalls = append(f.downloadFileCalls, DownloadFileCall{File: file, W: w})
+	f.mu.Unlock()
+
+	if f.DownloadFileFunc != nil {
+		return f.DownloadFileFunc(ctx, file, w)
+	}
+
+	return nil
+}
+
+// DownloadFileCalls returns arguments of DownloadFile calls
+func (f *Files) DownloadFileCalls() []DownloadFileCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]DownloadFileCall(nil), f.downloadFileCalls...)
+}
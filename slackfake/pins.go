@@ -0,0 +1,112 @@
+// Package slackfake - fake of slack.Pins
+package slackfake
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kryabinin/go-slack"
+)
+
+type (
+	// AddPinCall arguments of a AddPin call
+	AddPinCall struct {
+		// Msg msg argument
+		Msg slack.MessageRef
+	}
+
+	// RemovePinCall arguments of a RemovePin call
+	RemovePinCall struct {
+		// Msg msg argument
+		Msg slack.MessageRef
+	}
+
+	// ListPinsCall arguments of a ListPins call
+	ListPinsCall struct {
+		// Channel channel argument
+		Channel string
+	}
+
+	// Pins fake of slack.Pins recording calls. Methods return results of the funcs when they are set,
+	// zero values otherwise
+	Pins struct {
+		// AddPinFunc returns result of AddPin
+		AddPinFunc func(ctx context.Context, msg slack.MessageRef) error
+
+		// RemovePinFunc returns result of RemovePin
+		RemovePinFunc func(ctx context.Context, msg slack.MessageRef) error
+
+		// ListPinsFunc returns result of ListPins
+		ListPinsFunc func(ctx context.Context, channel string) ([]slack.Pin, error)
+
+		mu             sync.Mutex
+		addPinCalls    []AddPinCall
+		removePinCalls []RemovePinCall
+		listPinsCalls  []ListPinsCall
+	}
+)
+
+var _ slack.Pins = (*Pins)(nil)
+
+// AddPin implementation
+func (f *Pins) AddPin(ctx context.Context, msg slack.MessageRef) error {
+	f.mu.Lock()
+	f.addPinCalls = append(f.addPinCalls, AddPinCall{Msg: msg})
+	f.mu.Unlock()
+
+	if f.AddPinFunc != nil {
+		return f.AddPinFunc(ctx, msg)
+	}
+
+	return nil
+}
+
+// AddPinCalls returns arguments of AddPin calls
+func (f *Pins) AddPinCalls() []AddPinCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]AddPinCall(nil), f.addPinCalls...)
+}
+
+// RemovePin implementation
+func (f *Pins) RemovePin(ctx context.Context, msg slack.MessageRef) error {
+	f.mu.Lock()
+	f.removePinCalls = append(f.removePinCalls, RemovePinCall{Msg: msg})
+	f.mu.Unlock()
+
+	if f.RemovePinFunc != nil {
+		return f.RemovePinFunc(ctx, msg)
+	}
+
+	return nil
+}
+
+// RemovePinCalls returns arguments of RemovePin calls
+func (f *Pins) RemovePinCalls() []RemovePinCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]RemovePinCall(nil), f.removePinCalls...)
+}
+
+// ListPins implementation
+func (f *Pins) ListPins(ctx context.Context, channel string) ([]slack.Pin, error) {
+	f.mu.Lock()
+	f.listPinsCalls = append(f.listPinsCalls, ListPinsCall{Channel: channel})
+	f.mu.Unlock()
+
+	if f.ListPinsFunc != nil {
+		return f.ListPinsFunc(ctx, channel)
+	}
+
+	return nil, nil
+}
+
+// ListPinsCalls returns arguments of ListPins calls
+func (f *Pins) ListPinsCalls() []ListPinsCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]ListPinsCall(nil), f.listPinsCalls...)
+}
@@ -0,0 +1,149 @@
+// Package slackfake - fake of slack.Reactions
+package slackfake
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kryabinin/go-slack"
+)
+
+type (
+	// AddReactionCall arguments of a AddReaction call
+	AddReactionCall struct {
+		// Name name argument
+		Name string
+
+		// Msg msg argument
+		Msg slack.MessageRef
+	}
+
+	// RemoveReactionCall arguments of a RemoveReaction call
+	RemoveReactionCall struct {
+		// Name name argument
+		Name string
+
+		// Item item argument
+		Item slack.ItemRef
+	}
+
+	// GetReactionsCall arguments of a GetReactions call
+	GetReactionsCall struct {
+		// Item item argument
+		Item slack.ItemRef
+	}
+
+	// ListReactionsCall arguments of a ListReactions call
+	ListReactionsCall struct {
+		// Opts opts argument
+		Opts []slack.ListOption
+	}
+
+	// Reactions fake of slack.Reactions recording calls. Methods return results of the funcs when they are set,
+	// zero values otherwise
+	Reactions struct {
+		// AddReactionFunc returns result of AddReaction
+		AddReactionFunc func(ctx context.Context, name string, msg slack.MessageRef) error
+
+		// RemoveReactionFunc returns result of RemoveReaction
+		RemoveReactionFunc func(ctx context.Context, name string, item slack.ItemRef) error
+
+		// GetReactionsFunc returns result of GetReactions
+		GetReactionsFunc func(ctx context.Context, item slack.ItemRef) (slack.ReactedItem, error)
+
+		// ListReactionsFunc returns result of ListReactions
+		ListReactionsFunc func(ctx context.Context, opts ...slack.ListOption) (slack.ReactedItems, error)
+
+		mu                  sync.Mutex
+		addReactionCalls    []AddReactionCall
+		removeReactionCalls []RemoveReactionCall
+		getReactionsCalls   []GetReactionsCall
+		listReactionsCalls  []ListReactionsCall
+	}
+)
+
+var _ slack.Reactions = (*Reactions)(nil)
+
+// AddReaction implementation
+func (f *Reactions) AddReaction(ctx context.Context, name string, msg slack.MessageRef) error {
+	f.mu.Lock()
+	f.addReactionCalls = append(f.addReactionCalls, AddReactionCall{Name: name, Msg: msg})
+	f.mu.Unlock()
+
+	if f.AddReactionFunc != nil {
+		return f.AddReactionFunc(ctx, name, msg)
+	}
+
+	return nil
+}
+
+// AddReactionCalls returns arguments of AddReaction calls
+func (f *Reactions) AddReactionCalls() []AddReactionCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]AddReactionCall(nil), f.addReactionCalls...)
+}
+
+// RemoveReaction implementation
+func (f *Reactions) RemoveReaction(ctx context.Context, name string, item slack.ItemRef) error {
+	f.mu.Lock()
+	f.removeReactionCalls = append(f.removeReactionCalls, RemoveReactionCall{Name: name, Item: item})
+	f.mu.Unlock()
+
+	if f.RemoveReactionFunc != nil {
+		return f.RemoveReactionFunc(ctx, name, item)
+	}
+
+	return nil
+}
+
+// RemoveReactionCalls returns arguments of RemoveReaction calls
+func (f *Reactions) RemoveReactionCalls() []RemoveReactionCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]RemoveReactionCall(nil), f.removeReactionCalls...)
+}
+
+// GetReactions implementation
+func (f *Reactions) GetReactions(ctx context.Context, item slack.ItemRef) (slack.ReactedItem, error) {
+	f.mu.Lock()
+	f.getReactionsCalls = append(f.getReactionsCalls, GetReactionsCall{Item: item})
+	f.mu.Unlock()
+
+	if f.GetReactionsFunc != nil {
+		return f.GetReactionsFunc(ctx, item)
+	}
+
+	return slack.ReactedItem{}, nil
+}
+
+// GetReactionsCalls returns arguments of GetReactions calls
+func (f *Reactions) GetReactionsCalls() []GetReactionsCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]GetReactionsCall(nil), f.getReactionsCalls...)
+}
+
+// ListReactions implementation
+func (f *Reactions) ListReactions(ctx context.Context, opts ...slack.ListOption) (slack.ReactedItems, error) {
+	f.mu.Lock()
+	f.listReactionsCalls = append(f.listReactionsCalls, ListReactionsCall{Opts: opts})
+	f.mu.Unlock()
+
+	if f.ListReactionsFunc != nil {
+		return f.ListReactionsFunc(ctx, opts...)
+	}
+
+	return slack.ReactedItems{}, nil
+}
+
+// ListReactionsCalls returns arguments of ListReactions calls
+func (f *Reactions) ListReactionsCalls() []ListReactionsCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]ListReactionsCall(nil), f.listReactionsCalls...)
+}
@@ -0,0 +1,50 @@
+// Package slackfake - fake of slack.Teams
+package slackfake
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kryabinin/go-slack"
+)
+
+type (
+	// GetTeamInfoCall arguments of a GetTeamInfo call
+	GetTeamInfoCall struct {
+		// TeamID teamID argument
+		TeamID string
+	}
+
+	// Teams fake of slack.Teams recording calls. Methods return results of the funcs when they are set,
+	// zero values otherwise
+	Teams struct {
+		// GetTeamInfoFunc returns result of GetTeamInfo
+		GetTeamInfoFunc func(ctx context.Context, teamID string) (slack.Team, error)
+
+		mu               sync.Mutex
+		getTeamInfoCalls []GetTeamInfoCall
+	}
+)
+
+var _ slack.Teams = (*Teams)(nil)
+
+// GetTeamInfo implementation
+func (f *Teams) GetTeamInfo(ctx context.Context, teamID string) (slack.Team, error) {
+	f.mu.Lock()
+	f.getTeamInfoCalls = append(f.getTeamInfoCalls, GetTeamInfoCall{TeamID: teamID})
+	f.mu.Unlock()
+
+	if f.GetTeamInfoFunc != nil {
+		return f.GetTeamInfoFunc(ctx, teamID)
+	}
+
+	return slack.Team{}, nil
+}
+
+// GetTeamInfoCalls returns arguments of GetTeamInfo calls
+func (f *Teams) GetTeamInfoCalls() []GetTeamInfoCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]GetTeamInfoCall(nil), f.getTeamInfoCalls...)
+}
@@ -0,0 +1,50 @@
+// Package slackfake - fake of slack.Users
+package slackfake
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kryabinin/go-slack"
+)
+
+type (
+	// GetUserByEmailCall arguments of a GetUserByEmail call
+	GetUserByEmailCall struct {
+		// Email email argument
+		Email string
+	}
+
+	// Users fake of slack.Users recording calls. Methods return results of the funcs when they are set,
+	// zero values otherwise
+	Users struct {
+		// GetUserByEmailFunc returns result of GetUserByEmail
+		GetUserByEmailFunc func(ctx context.Context, email string) (slack.User, error)
+
+		mu                  sync.Mutex
+		getUserByEmailCalls []GetUserByEmailCall
+	}
+)
+
+var _ slack.Users = (*Users)(nil)
+
+// GetUserByEmail implementation
+func (f *Users) GetUserByEmail(ctx context.Context, email string) (slack.User, error) {
+	f.mu.Lock()
+	f.getUserByEmailCalls = append(f.getUserByEmailCalls, GetUserByEmailCall{Email: email})
+	f.mu.Unlock()
+
+	if f.GetUserByEmailFunc != nil {
+		return f.GetUserByEmailFunc(ctx, email)
+	}
+
+	return slack.User{}, nil
+}
+
+// GetUserByEmailCalls returns arguments of GetUserByEmail calls
+func (f *Users) GetUserByEmailCalls() []GetUserByEmailCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]GetUserByEmailCall(nil), f.getUserByEmailCalls...)
+}
@@ -0,0 +1,161 @@
+// Package slackfake - fake of slack.Views
+package slackfake
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kryabinin/go-slack"
+)
+
+type (
+	// OpenViewCall arguments of a OpenView call
+	OpenViewCall struct {
+		// TriggerID triggerID argument
+		TriggerID string
+
+		// View view argument
+		View slack.View
+	}
+
+	// PushViewCall arguments of a PushView call
+	PushViewCall struct {
+		// TriggerID triggerID argument
+		TriggerID string
+
+		// View view argument
+		View slack.View
+	}
+
+	// UpdateViewCall arguments of a UpdateView call
+	UpdateViewCall struct {
+		// ViewID viewID argument
+		ViewID string
+
+		// Hash hash argument
+		Hash string
+
+		// View view argument
+		View slack.View
+	}
+
+	// PublishHomeViewCall arguments of a PublishHomeView call
+	PublishHomeViewCall struct {
+		// UserID userID argument
+		UserID string
+
+		// Hash hash argument
+		Hash string
+
+		// View view argument
+		View slack.View
+	}
+
+	// Views fake of slack.Views recording calls. Methods return results of the funcs when they are set,
+	// zero values otherwise
+	Views struct {
+		// OpenViewFunc returns result of OpenView
+		OpenViewFunc func(ctx context.Context, triggerID string, view slack.View) (slack.View, error)
+
+		// PushViewFunc returns result of PushView
+		PushViewFunc func(ctx context.Context, triggerID string, view slack.View) (slack.View, error)
+
+		// UpdateViewFunc returns result of UpdateView
+		UpdateViewFunc func(ctx context.Context, viewID string, hash string, view slack.View) (slack.View, error)
+
+		// PublishHomeViewFunc returns result of PublishHomeView
+		PublishHomeViewFunc func(ctx context.Context, userID string, hash string, view slack.View) (slack.View, error)
+
+		mu                   sync.Mutex
+		openViewCalls        []OpenViewCall
+		pushViewCalls        []PushViewCall
+		updateViewCalls      []UpdateViewCall
+		publishHomeViewCalls []PublishHomeViewCall
+	}
+)
+
+var _ slack.Views = (*Views)(nil)
+
+// OpenView implementation
+func (f *Views) OpenView(ctx context.Context, triggerID string, view slack.View) (slack.View, error) {
+	f.mu.Lock()
+	f.openViewCalls = append(f.openViewCalls, OpenViewCall{TriggerID: triggerID, View: view})
+	f.mu.Unlock()
+
+	if f.OpenViewFunc != nil {
+		return f.OpenViewFunc(ctx, triggerID, view)
+	}
+
+	return slack.View{}, nil
+}
+
+// OpenViewCalls returns arguments of OpenView calls
+func (f *Views) OpenViewCalls() []OpenViewCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]OpenViewCall(nil), f.openViewCalls...)
+}
+
+// PushView implementation
+func (f *Views) PushView(ctx context.Context, triggerID string, view slack.View) (slack.View, error) {
+	f.mu.Lock()
+	f.pushViewCalls = append(f.pushViewCalls, PushViewCall{TriggerID: triggerID, View: view})
+	f.mu.Unlock()
+
+	if f.PushViewFunc != nil {
+		return f.PushViewFunc(ctx, triggerID, view)
+	}
+
+	return slack.View{}, nil
+}
+
+// PushViewCalls returns arguments of PushView calls
+func (f *Views) PushViewCalls() []PushViewCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]PushViewCall(nil), f.pushViewCalls...)
+}
+
+// UpdateView implementation
+func (f *Views) UpdateView(ctx context.Context, viewID string, hash string, view slack.View) (slack.View, error) {
+	f.mu.Lock()
+	f.updateViewCalls = append(f.updateViewCalls, UpdateViewCall{ViewID: viewID, Hash: hash, View: view})
+	f.mu.Unlock()
+
+	if f.UpdateViewFunc != nil {
+		return f.UpdateViewFunc(ctx, viewID, hash, view)
+	}
+
+	return slack.View{}, nil
+}
+
+// UpdateViewCalls returns arguments of UpdateView calls
+func (f *Views) UpdateViewCalls() []UpdateViewCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]UpdateViewCall(nil), f.updateViewCalls...)
+}
+
+// PublishHomeView implementation
+func (f *Views) PublishHomeView(ctx context.Context, userID string, hash string, view slack.View) (slack.View, error) {
+	f.mu.Lock()
+	f.publishHomeViewCalls = append(f.publishHomeViewCalls, PublishHomeViewCall{UserID: userID, Hash: hash, View: view})
+	f.mu.Unlock()
+
+	if f.PublishHomeViewFunc != nil {
+		return f.PublishHomeViewFunc(ctx, userID, hash, view)
+	}
+
+	return slack.View{}, nil
+}
+
+// PublishHomeViewCalls returns arguments of PublishHomeView calls
+func (f *Views) PublishHomeViewCalls() []PublishHomeViewCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]PublishHomeViewCall(nil), f.publishHomeViewCalls...)
+}
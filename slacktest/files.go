@@ -0,0 +1,195 @@
+// Package slacktest - files of the fake
+package slacktest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kryabinin/go-slack"
+)
+
+// uploadPath path of upload urls returned by files.getUploadURLExternal
+const uploadPath = "/upload/"
+
+// file uploaded file, it's listed only after files.completeUploadExternal
+type file struct {
+	slack.File
+
+	content   []byte
+	uploaded  bool
+	completed bool
+	reactions []slack.Reaction
+}
+
+// FileContent returns content uploaded to the file
+func (s *Server) FileContent(fileID string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f := s.findFile(fileID)
+	if f == nil || !f.uploaded {
+		return nil, false
+	}
+
+	return f.content, true
+}
+
+func (s *Server) serveUpload(w http.ResponseWriter, r *http.Request) {
+	content, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f := s.findFile(strings.TrimPrefix(r.URL.Path, uploadPath))
+	if r.Method != http.MethodPost || f == nil || f.completed {
+		http.NotFound(w, r)
+		return
+	}
+
+	f.content, f.uploaded = content, true
+
+	_, _ = fmt.Fprintf(w, "OK - %d", len(content))
+}
+
+func (s *Server) getUploadURL(params map[string]string) response {
+	if len(params["filename"]) == 0 {
+		return errorResponse("invalid_arguments")
+	}
+
+	size, err := strconv.ParseInt(params["length"], 10, 64)
+	if err != nil || size <= 0 {
+		return errorResponse("invalid_length")
+	}
+
+	id := s.nextID("F")
+	name := params["filename"]
+
+	s.files = append(s.files, &file{File: slack.File{
+		ID:                 id,
+		Created:            time.Now().Unix(),
+		Name:               name,
+		Title:              name,
+		Filetype:           params["snippet_type"],
+		User:               BotUserID,
+		Team:               TeamID,
+		UserTeam:           TeamID,
+		SourceTeam:         TeamID,
+		Size:               size,
+		UrlPrivate:         "https://files.slack.com/files-pri/" + TeamID + "-" + id + "/" + name,
+		UrlPrivateDownload: "https://files.slack.com/files-pri/" + TeamID + "-" + id + "/download/" + name,
+		Permalink:          "https://test.slack.com/files/" + BotUserID + "/" + id + "/" + name,
+		AltText:            params["alt_txt"],
+	}})
+
+	return okResponse(response{"upload_url": s.URL + uploadPath + id, "file_id": id})
+}
+
+func (s *Server) completeUpload(params map[string]string) response {
+	var uploads []struct {
+		ID    string `json:"id"`
+		Title string `json:"title"`
+	}
+
+	if err := json.Unmarshal([]byte(params["files"]), &uploads); err != nil || len(uploads) == 0 {
+		return errorResponse("invalid_arguments")
+	}
+
+	var ch *Channel
+	if channel := params["channel_id"]; len(channel) > 0 {
+		if ch = s.findChannel(channel); ch == nil {
+			return errorResponse("channel_not_found")
+		}
+	}
+
+	// all files are checked before any of them is completed
+	files := make([]*file, 0, len(uploads))
+	for _, upload := range uploads {
+		f := s.findFile(upload.ID)
+		if f == nil || !f.uploaded || f.completed {
+			return errorResponse("file_not_found")
+		}
+
+		files = append(files, f)
+	}
+
+	completed := make([]slack.File, 0, len(files))
+	for i, f := range files {
+		f.completed = true
+
+		if len(uploads[i].Title) > 0 {
+			f.Title = uploads[i].Title
+		}
+
+		if ch != nil && ch.IsPrivate {
+			f.Groups = append(f.Groups, ch.ID)
+		} else if ch != nil {
+			f.Channels = append(f.Channels, ch.ID)
+			f.IsPublic = true
+		}
+
+		completed = append(completed, f.File)
+	}
+
+	return okResponse(response{"files": completed})
+}
+
+func (s *Server) fileInfo(params map[string]string) response {
+	f := s.findFile(params["file"])
+	if f == nil || !f.completed {
+		return errorResponse("file_not_found")
+	}
+
+	return okResponse(response{"file": f.File})
+}
+
+func (s *Server) listFiles(params map[string]string) response {
+	files := []slack.File{}
+	for _, f := range s.files {
+		if !f.completed {
+			continue
+		}
+
+		if channel := params["channel"]; len(channel) > 0 && !contains(f.Channels, channel) &&
+			!contains(f.Groups, channel) {
+			continue
+		}
+
+		files = append(files, f.File)
+	}
+
+	return okResponse(response{
+		"files":  files,
+		"paging": slack.Paging{Count: len(files), Total: len(files), Page: 1, Pages: 1},
+	})
+}
+
+func (s *Server) deleteFile(params map[string]string) response {
+	for i, f := range s.files {
+		if f.ID == params["file"] && f.completed {
+			s.files = append(s.files[:i:i], s.files[i+1:]...)
+
+			return okResponse(response{})
+		}
+	}
+
+	return errorResponse("file_not_found")
+}
+
+func (s *Server) findFile(fileID string) *file {
+	for _, f := range s.files {
+		if f.ID == fileID {
+			return f
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,388 @@
+// Package slacktest - reactions, pins and bookmarks of the fake
+package slacktest
+
+import (
+	"time"
+
+	"github.com/kryabinin/go-slack"
+)
+
+type pin struct {
+	channel   string
+	timestamp string
+	created   int64
+}
+
+// reactionsOf returns reactions of the message or the file referenced by the params and an error code if the item is
+// not found
+func (s *Server) reactionsOf(params map[string]string) (*[]slack.Reaction, string) {
+	if fileID := params["file"]; len(fileID) > 0 {
+		f := s.findFile(fileID)
+		if f == nil {
+			return nil, "file_not_found"
+		}
+
+		return &f.reactions, ""
+	}
+
+	if len(params["channel"]) == 0 || len(params["timestamp"]) == 0 {
+		return nil, "no_item_specified"
+	}
+
+	ch := s.findChannel(params["channel"])
+	if ch == nil {
+		return nil, "channel_not_found"
+	}
+
+	msg := s.findMessage(ch.ID, params["timestamp"])
+	if msg == nil {
+		return nil, "message_not_found"
+	}
+
+	return &msg.Reactions, ""
+}
+
+func (s *Server) addReaction(params map[string]string) response {
+	if len(params["name"]) == 0 {
+		return errorResponse("invalid_name")
+	}
+
+	reactions, code := s.reactionsOf(params)
+	if reactions == nil {
+		return errorResponse(code)
+	}
+
+	for i := range *reactions {
+		reaction := &(*reactions)[i]
+		if reaction.Name != params["name"] {
+			continue
+		}
+
+		if contains(reaction.Users, BotUserID) {
+			return errorResponse("already_reacted")
+		}
+
+		reaction.Users = append(reaction.Users, BotUserID)
+		reaction.Count++
+
+		return okResponse(response{})
+	}
+
+	*reactions = append(*reactions, slack.Reaction{Name: params["name"], Count: 1, Users: []string{BotUserID}})
+
+	return okResponse(response{})
+}
+
+func (s *Server) removeReaction(params map[string]string) response {
+	reactions, code := s.reactionsOf(params)
+	if reactions == nil {
+		return errorResponse(code)
+	}
+
+	for i := range *reactions {
+		reaction := &(*reactions)[i]
+		if reaction.Name != params["name"] || !contains(reaction.Users, BotUserID) {
+			continue
+		}
+
+		reaction.Users = remove(reaction.Users, BotUserID)
+		if reaction.Count--; reaction.Count == 0 {
+			*reactions = append((*reactions)[:i:i], (*reactions)[i+1:]...)
+		}
+
+		return okResponse(response{})
+	}
+
+	return errorResponse("no_reaction")
+}
+
+func (s *Server) getReactions(params map[string]string) response {
+	reactions, code := s.reactionsOf(params)
+	if reactions == nil {
+		return errorResponse(code)
+	}
+
+	if fileID := params["file"]; len(fileID) > 0 {
+		return okResponse(response{"type": "file", "file": s.reactedFile(s.findFile(fileID))})
+	}
+
+	ch := s.findChannel(params["channel"])
+
+	return okResponse(response{
+		"type":    "message",
+		"channel": ch.ID,
+		"message": reactedMessage(s.findMessage(ch.ID, params["timestamp"])),
+	})
+}
+
+func (s *Server) listReactions(params map[string]string) response {
+	user := params["user"]
+	if len(user) == 0 {
+		user = BotUserID
+	}
+
+	items := []slack.ReactedItem{}
+	for _, ch := range s.channels {
+		for _, msg := range s.messages[ch.ID] {
+			if reactedBy(msg.Reactions, user) {
+				items = append(items, slack.ReactedItem{Type: "message", Channel: ch.ID, Message: reactedMessage(msg)})
+			}
+		}
+	}
+
+	for _, f := range s.files {
+		if f.completed && reactedBy(f.reactions, user) {
+			items = append(items, slack.ReactedItem{Type: "file", File: s.reactedFile(f)})
+		}
+	}
+
+	return okResponse(response{"items": items, "response_metadata": slack.ResponseMetadata{}})
+}
+
+func (s *Server) addPin(params map[string]string) response {
+	ch, msg, code := s.pinnedMessage(params)
+	if msg == nil {
+		return errorResponse(code)
+	}
+
+	if s.findPin(ch.ID, msg.Timestamp) >= 0 {
+		return errorResponse("already_pinned")
+	}
+
+	s.pins = append(s.pins, &pin{channel: ch.ID, timestamp: msg.Timestamp, created: time.Now().Unix()})
+
+	return okResponse(response{})
+}
+
+func (s *Server) removePin(params map[string]string) response {
+	ch, msg, code := s.pinnedMessage(params)
+	if msg == nil {
+		return errorResponse(code)
+	}
+
+	i := s.findPin(ch.ID, msg.Timestamp)
+	if i < 0 {
+		return errorResponse("no_pin")
+	}
+
+	s.pins = append(s.pins[:i:i], s.pins[i+1:]...)
+
+	return okResponse(response{})
+}
+
+func (s *Server) listPins(params map[string]string) response {
+	ch := s.findChannel(params["channel"])
+	if ch == nil {
+		return errorResponse("channel_not_found")
+	}
+
+	items := []slack.Pin{}
+	for _, p := range s.pins {
+		if p.channel != ch.ID {
+			continue
+		}
+
+		msg := s.findMessage(p.channel, p.timestamp)
+		if msg == nil {
+			continue
+		}
+
+		items = append(items, slack.Pin{
+			Type:      "message",
+			Channel:   p.channel,
+			Created:   p.created,
+			CreatedBy: BotUserID,
+			Message: &slack.PinnedMessage{
+				Type:       "message",
+				Text:       msg.Text,
+				User:       msg.User,
+				Team:       TeamID,
+				UserTeam:   TeamID,
+				SourceTeam: TeamID,
+				Timestamp:  msg.Timestamp,
+				PinnedTo:   []string{p.channel},
+			},
+		})
+	}
+
+	return okResponse(response{"items": items})
+}
+
+func (s *Server) addBookmark(params map[string]string) response {
+	if s.findChannel(params["channel_id"]) == nil {
+		return errorResponse("channel_not_found")
+	}
+
+	if len(params["title"]) == 0 {
+		return errorResponse("invalid_arguments")
+	}
+
+	if params["type"] != "link" {
+		return errorResponse("invalid_bookmark_type")
+	}
+
+	now := time.Now().Unix()
+	bookmark := &slack.Bookmark{
+		ID:                  s.nextID("Bk"),
+		ChannelID:           s.findChannel(params["channel_id"]).ID,
+		Title:               params["title"],
+		Link:                params["link"],
+		Emoji:               params["emoji"],
+		Type:                params["type"],
+		DateCreated:         now,
+		DateUpdated:         now,
+		LastUpdatedByUserID: BotUserID,
+		LastUpdatedByTeamID: TeamID,
+		Team:                TeamID,
+		UserTeam:            TeamID,
+		SourceTeam:          TeamID,
+	}
+
+	s.bookmarks = append(s.bookmarks, bookmark)
+
+	return okResponse(response{"bookmark": bookmark})
+}
+
+func (s *Server) editBookmark(params map[string]string) response {
+	i, code := s.findBookmark(params)
+	if i < 0 {
+		return errorResponse(code)
+	}
+
+	bookmark := s.bookmarks[i]
+	for field, value := range map[string]*string{
+		"title": &bookmark.Title,
+		"link":  &bookmark.Link,
+		"emoji": &bookmark.Emoji,
+	} {
+		if len(params[field]) > 0 {
+			*value = params[field]
+		}
+	}
+
+	bookmark.DateUpdated = time.Now().Unix()
+
+	return okResponse(response{"bookmark": bookmark})
+}
+
+func (s *Server) removeBookmark(params map[string]string) response {
+	i, code := s.findBookmark(params)
+	if i < 0 {
+		return errorResponse(code)
+	}
+
+	s.bookmarks = append(s.bookmarks[:i:i], s.bookmarks[i+1:]...)
+
+	return okResponse(response{})
+}
+
+func (s *Server) listBookmarks(params map[string]string) response {
+	ch := s.findChannel(params["channel_id"])
+	if ch == nil {
+		return errorResponse("channel_not_found")
+	}
+
+	bookmarks := []*slack.Bookmark{}
+	for _, bookmark := range s.bookmarks {
+		if bookmark.ChannelID == ch.ID {
+			bookmarks = append(bookmarks, bookmark)
+		}
+	}
+
+	return okResponse(response{"bookmarks": bookmarks})
+}
+
+func (s *Server) pinnedMessage(params map[string]string) (*Channel, *Message, string) {
+	ch := s.findChannel(params["channel"])
+	if ch == nil {
+		return nil, nil, "channel_not_found"
+	}
+
+	msg := s.findMessage(ch.ID, params["timestamp"])
+	if msg == nil {
+		return nil, nil, "message_not_found"
+	}
+
+	return ch, msg, ""
+}
+
+func (s *Server) findPin(channel, ts string) int {
+	for i, p := range s.pins {
+		if p.channel == channel && p.timestamp == ts {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (s *Server) findBookmark(params map[string]string) (int, string) {
+	ch := s.findChannel(params["channel_id"])
+	if ch == nil {
+		return -1, "channel_not_found"
+	}
+
+	for i, bookmark := range s.bookmarks {
+		if bookmark.ChannelID == ch.ID && bookmark.ID == params["bookmark_id"] {
+			return i, ""
+		}
+	}
+
+	return -1, "bookmark_not_found"
+}
+
+func (s *Server) reactedFile(f *file) *slack.ReactedFile {
+	return &slack.ReactedFile{
+		ID:         f.ID,
+		Name:       f.Name,
+		Title:      f.Title,
+		Team:       f.Team,
+		UserTeam:   f.UserTeam,
+		SourceTeam: f.SourceTeam,
+		Reactions:  append([]slack.Reaction{}, f.reactions...),
+	}
+}
+
+func reactedMessage(msg *Message) *slack.ReactedMessage {
+	return &slack.ReactedMessage{
+		Type:       "message",
+		Text:       msg.Text,
+		User:       msg.User,
+		Team:       TeamID,
+		UserTeam:   TeamID,
+		SourceTeam: TeamID,
+		Timestamp:  msg.Timestamp,
+		Reactions:  append([]slack.Reaction{}, msg.Reactions...),
+	}
+}
+
+func reactedBy(reactions []slack.Reaction, user string) bool {
+	for _, reaction := range reactions {
+		if contains(reaction.Users, user) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func remove(values []string, value string) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if v != value {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
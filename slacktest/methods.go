@@ -0,0 +1,321 @@
+// Package slacktest - api methods of the fake
+package slacktest
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kryabinin/go-slack"
+)
+
+type response map[string]interface{}
+
+var methods = map[string]func(s *Server, params map[string]string) response{
+	"auth.test":             (*Server).authTest,
+	"team.info":             (*Server).teamInfo,
+	"chat.postMessage":      (*Server).postMessage,
+	"chat.update":           (*Server).updateMessage,
+	"chat.delete":           (*Server).deleteMessage,
+	"users.info":            (*Server).userInfo,
+	"users.lookupByEmail":   (*Server).lookupUserByEmail,
+	"users.list":            (*Server).listUsers,
+	"conversations.create":  (*Server).createConversation,
+	"conversations.info":    (*Server).conversationInfo,
+	"conversations.list":    (*Server).listConversations,
+	"conversations.history": (*Server).conversationHistory,
+	"conversations.replies": (*Server).conversationReplies,
+	"conversations.archive": (*Server).archiveConversation,
+	"conversations.rename":  (*Server).renameConversation,
+
+	"reactions.add":    (*Server).addReaction,
+	"reactions.remove": (*Server).removeReaction,
+	"reactions.get":    (*Server).getReactions,
+	"reactions.list":   (*Server).listReactions,
+	"pins.add":         (*Server).addPin,
+	"pins.remove":      (*Server).removePin,
+	"pins.list":        (*Server).listPins,
+	"bookmarks.add":    (*Server).addBookmark,
+	"bookmarks.edit":   (*Server).editBookmark,
+	"bookmarks.remove": (*Server).removeBookmark,
+	"bookmarks.list":   (*Server).listBookmarks,
+
+	"files.getUploadURLExternal":   (*Server).getUploadURL,
+	"files.completeUploadExternal": (*Server).completeUpload,
+	"files.info":                   (*Server).fileInfo,
+	"files.list":                   (*Server).listFiles,
+	"files.delete":                 (*Server).deleteFile,
+
+	"views.open":    (*Server).openView,
+	"views.push":    (*Server).pushView,
+	"views.update":  (*Server).updateView,
+	"views.publish": (*Server).publishView,
+}
+
+func okResponse(fields response) response {
+	fields["ok"] = true
+
+	return fields
+}
+
+func errorResponse(code string) response {
+	return response{"ok": false, "error": code}
+}
+
+func (s *Server) authTest(map[string]string) response {
+	return okResponse(response{
+		"url":     "https://test.slack.com/",
+		"team":    "Test",
+		"user":    "bot",
+		"team_id": TeamID,
+		"user_id": BotUserID,
+	})
+}
+
+func (s *Server) teamInfo(params map[string]string) response {
+	if team := params["team"]; len(team) > 0 && team != TeamID {
+		return errorResponse("team_not_found")
+	}
+
+	return okResponse(response{
+		"team": slack.Team{ID: TeamID, Name: "Test", Domain: "test"},
+	})
+}
+
+func (s *Server) postMessage(params map[string]string) response {
+	ch := s.findChannel(params["channel"])
+	if ch == nil {
+		return errorResponse("channel_not_found")
+	}
+
+	if ch.IsArchived {
+		return errorResponse("is_archived")
+	}
+
+	if len(params["text"]) == 0 && len(params["attachments"]) == 0 && len(params["blocks"]) == 0 {
+		return errorResponse("no_text")
+	}
+
+	msg := &Message{
+		Type:            "message",
+		Channel:         ch.ID,
+		User:            BotUserID,
+		Team:            TeamID,
+		Text:            params["text"],
+		Timestamp:       s.nextTimestamp(),
+		ThreadTimestamp: params["thread_ts"],
+	}
+
+	if len(params["attachments"]) > 0 {
+		msg.Attachments = json.RawMessage(params["attachments"])
+	}
+
+	if len(msg.ThreadTimestamp) > 0 {
+		parent := s.findMessage(ch.ID, msg.ThreadTimestamp)
+		if parent == nil {
+			return errorResponse("thread_not_found")
+		}
+
+		parent.ThreadTimestamp = parent.Timestamp
+		parent.ReplyCount++
+	}
+
+	s.messages[ch.ID] = append(s.messages[ch.ID], msg)
+
+	return okResponse(response{"channel": ch.ID, "ts": msg.Timestamp, "message": msg})
+}
+
+func (s *Server) updateMessage(params map[string]string) response {
+	ch := s.findChannel(params["channel"])
+	if ch == nil {
+		return errorResponse("channel_not_found")
+	}
+
+	msg := s.findMessage(ch.ID, params["ts"])
+	if msg == nil {
+		return errorResponse("message_not_found")
+	}
+
+	msg.Text = params["text"]
+	msg.Edited = true
+
+	return okResponse(response{"channel": msg.Channel, "ts": msg.Timestamp, "text": msg.Text})
+}
+
+func (s *Server) deleteMessage(params map[string]string) response {
+	ch := s.findChannel(params["channel"])
+	if ch == nil {
+		return errorResponse("channel_not_found")
+	}
+
+	messages := s.messages[ch.ID]
+	for i, msg := range messages {
+		if msg.Timestamp == params["ts"] {
+			s.messages[ch.ID] = append(messages[:i:i], messages[i+1:]...)
+
+			return okResponse(response{"channel": msg.Channel, "ts": msg.Timestamp})
+		}
+	}
+
+	return errorResponse("message_not_found")
+}
+
+func (s *Server) userInfo(params map[string]string) response {
+	for _, user := range s.users {
+		if user.ID == params["user"] {
+			return okResponse(response{"user": user})
+		}
+	}
+
+	return errorResponse("user_not_found")
+}
+
+func (s *Server) lookupUserByEmail(params map[string]string) response {
+	for _, user := range s.users {
+		if strings.EqualFold(user.Profile.Email, params["email"]) {
+			return okResponse(response{"user": user})
+		}
+	}
+
+	return errorResponse("users_not_found")
+}
+
+func (s *Server) listUsers(map[string]string) response {
+	users := s.users
+	if users == nil {
+		users = []slack.User{}
+	}
+
+	return okResponse(response{"members": users, "response_metadata": slack.ResponseMetadata{}})
+}
+
+func (s *Server) createConversation(params map[string]string) response {
+	if len(params["name"]) == 0 {
+		return errorResponse("invalid_name_required")
+	}
+
+	if s.findChannel(params["name"]) != nil {
+		return errorResponse("name_taken")
+	}
+
+	private, _ := strconv.ParseBool(params["is_private"])
+
+	return okResponse(response{"channel": s.addChannel(params["name"], private, BotUserID)})
+}
+
+func (s *Server) conversationInfo(params map[string]string) response {
+	ch := s.findChannel(params["channel"])
+	if ch == nil {
+		return errorResponse("channel_not_found")
+	}
+
+	return okResponse(response{"channel": ch})
+}
+
+func (s *Server) listConversations(params map[string]string) response {
+	types := params["types"]
+	if len(types) == 0 {
+		types = "public_channel"
+	}
+
+	excludeArchived, _ := strconv.ParseBool(params["exclude_archived"])
+
+	channels := []*Channel{}
+	for _, ch := range s.channels {
+		if excludeArchived && ch.IsArchived {
+			continue
+		}
+
+		if ch.IsPrivate && strings.Contains(types, "private_channel") ||
+			!ch.IsPrivate && strings.Contains(types, "public_channel") {
+			channels = append(channels, ch)
+		}
+	}
+
+	return okResponse(response{"channels": channels, "response_metadata": slack.ResponseMetadata{}})
+}
+
+func (s *Server) conversationHistory(params map[string]string) response {
+	ch := s.findChannel(params["channel"])
+	if ch == nil {
+		return errorResponse("channel_not_found")
+	}
+
+	messages := []*Message{}
+	for _, msg := range s.messages[ch.ID] {
+		if len(msg.ThreadTimestamp) == 0 || msg.ThreadTimestamp == msg.Timestamp {
+			messages = append(messages, msg)
+		}
+	}
+
+	sort.SliceStable(messages, func(i, j int) bool {
+		return messages[i].Timestamp > messages[j].Timestamp
+	})
+
+	hasMore := false
+	if limit, err := strconv.Atoi(params["limit"]); err == nil && limit > 0 && limit < len(messages) {
+		messages, hasMore = messages[:limit], true
+	}
+
+	return okResponse(response{
+		"messages":          messages,
+		"has_more":          hasMore,
+		"response_metadata": slack.ResponseMetadata{},
+	})
+}
+
+func (s *Server) conversationReplies(params map[string]string) response {
+	ch := s.findChannel(params["channel"])
+	if ch == nil {
+		return errorResponse("channel_not_found")
+	}
+
+	parent := s.findMessage(ch.ID, params["ts"])
+	if parent == nil {
+		return errorResponse("thread_not_found")
+	}
+
+	messages := []*Message{parent}
+	for _, msg := range s.messages[ch.ID] {
+		if msg != parent && msg.ThreadTimestamp == parent.Timestamp {
+			messages = append(messages, msg)
+		}
+	}
+
+	return okResponse(response{
+		"messages":          messages,
+		"has_more":          false,
+		"response_metadata": slack.ResponseMetadata{},
+	})
+}
+
+func (s *Server) archiveConversation(params map[string]string) response {
+	ch := s.findChannel(params["channel"])
+	if ch == nil {
+		return errorResponse("channel_not_found")
+	}
+
+	if ch.IsArchived {
+		return errorResponse("already_archived")
+	}
+
+	ch.IsArchived = true
+
+	return okResponse(response{})
+}
+
+func (s *Server) renameConversation(params map[string]string) response {
+	ch := s.findChannel(params["channel"])
+	if ch == nil {
+		return errorResponse("channel_not_found")
+	}
+
+	if other := s.findChannel(params["name"]); other != nil && other != ch {
+		return errorResponse("name_taken")
+	}
+
+	ch.Name = params["name"]
+
+	return okResponse(response{"channel": ch})
+}
@@ -0,0 +1,358 @@
+// Package slacktest - in-process fake of slack web api for tests
+package slacktest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kryabinin/go-slack"
+)
+
+const (
+	// TeamID workspace of the fake server
+	TeamID = "T0000TEST"
+
+	// BotUserID user the tokens of the fake server belong to
+	BotUserID = "U0000BOT"
+)
+
+type (
+	// Server fake of slack web api keeping channels, users, messages, reactions, pins, bookmarks, files and views in
+	// memory. Use BaseUrl with slack.WithBaseUrl option to send requests of the client to the fake. It covers auth,
+	// team, chat, users, conversations, reactions, pins, bookmarks, files and views methods used by the client, other
+	// methods respond with unknown_method error
+	Server struct {
+		*httptest.Server
+
+		mu         sync.Mutex
+		users      []slack.User
+		channels   []*Channel
+		messages   map[string][]*Message
+		pins       []*pin
+		bookmarks  []*slack.Bookmark
+		files      []*file
+		views      []*view
+		calls      []Call
+		errors     map[string]string
+		rateLimits map[string]*rateLimit
+		seq        int
+		epoch      int64
+	}
+
+	// Call request received by the fake
+	Call struct {
+		// Method slack api method, e.g. chat.postMessage
+		Method string
+
+		// Token token the request was authorized with
+		Token string
+
+		// Params query, form or json body parameters. Non-string json values are kept as json
+		Params map[string]string
+	}
+
+	// Channel conversation of the fake
+	Channel struct {
+		// ID channel identifier
+		ID string `json:"id"`
+
+		// Name channel name without #
+		Name string `json:"name"`
+
+		// IsChannel true for public channels
+		IsChannel bool `json:"is_channel"`
+
+		// IsPrivate true for private channels
+		IsPrivate bool `json:"is_private"`
+
+		// IsArchived true for archived channels
+		IsArchived bool `json:"is_archived"`
+
+		// Created creation time
+		Created int64 `json:"created"`
+
+		// Creator user created the channel
+		Creator string `json:"creator"`
+	}
+
+	// Message message posted to the fake
+	Message struct {
+		// Type always message
+		Type string `json:"type"`
+
+		// Channel channel of the message
+		Channel string `json:"-"`
+
+		// User author of the message
+		User string `json:"user"`
+
+		// Team workspace of the author
+		Team string `json:"team"`
+
+		// Text message text
+		Text string `json:"text"`
+
+		// Timestamp ts value of the message
+		Timestamp string `json:"ts"`
+
+		// ThreadTimestamp ts value of the parent message, empty for top-level messages
+		ThreadTimestamp string `json:"thread_ts,omitempty"`
+
+		// ReplyCount number of replies of a parent message
+		ReplyCount int `json:"reply_count,omitempty"`
+
+		// Attachments message attachments as json
+		Attachments json.RawMessage `json:"attachments,omitempty"`
+
+		// Reactions reactions of the message
+		Reactions []slack.Reaction `json:"reactions,omitempty"`
+
+		// Edited true if the message was updated
+		Edited bool `json:"-"`
+	}
+
+	rateLimit struct {
+		times      int
+		retryAfter time.Duration
+	}
+)
+
+// NewServer starts a fake server. Close it after the test
+func NewServer() *Server {
+	s := &Server{
+		messages:   make(map[string][]*Message),
+		errors:     make(map[string]string),
+		rateLimits: make(map[string]*rateLimit),
+		epoch:      time.Now().Unix(),
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// BaseUrl base url of the api to use with slack.WithBaseUrl
+func (s *Server) BaseUrl() string {
+	return s.URL + "/api"
+}
+
+// AddUser adds a user, users.lookupByEmail finds users by their profile email
+func (s *Server) AddUser(user slack.User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(user.TeamID) == 0 {
+		user.TeamID = TeamID
+	}
+
+	s.users = append(s.users, user)
+}
+
+// AddChannel adds a public channel with the name and returns its id
+func (s *Server) AddChannel(name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.addChannel(name, false, BotUserID).ID
+}
+
+// Messages returns messages of the channel including thread replies in order of posting
+func (s *Server) Messages(channel string) []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var messages []Message
+	if ch := s.findChannel(channel); ch != nil {
+		for _, msg := range s.messages[ch.ID] {
+			messages = append(messages, *msg)
+		}
+	}
+
+	return messages
+}
+
+// Calls returns received calls of the method, all calls when the method is empty
+func (s *Server) Calls(method string) []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var calls []Call
+	for _, call := range s.calls {
+		if len(method) == 0 || call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+// SetError makes the method fail with the error code, empty code removes the error
+func (s *Server) SetError(method, code string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(code) == 0 {
+		delete(s.errors, method)
+		return
+	}
+
+	s.errors[method] = code
+}
+
+// RateLimit makes the next calls of the method fail with 429 status code and the Retry-After header
+func (s *Server) RateLimit(method string, times int, retryAfter time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rateLimits[method] = &rateLimit{times: times, retryAfter: retryAfter}
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.HasPrefix(r.URL.Path, uploadPath) {
+		s.serveUpload(w, r)
+		return
+	}
+
+	method := path.Base(r.URL.Path)
+
+	params, err := requestParams(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if len(token) == 0 {
+		token = params["token"]
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls = append(s.calls, Call{Method: method, Token: token, Params: params})
+
+	if limit, ok := s.rateLimits[method]; ok && limit.times > 0 {
+		limit.times--
+
+		w.Header().Set("Retry-After", strconv.Itoa(int(limit.retryAfter/time.Second)))
+		w.WriteHeader(http.StatusTooManyRequests)
+		return
+	}
+
+	var resp interface{}
+	switch {
+	case len(token) == 0:
+		resp = errorResponse("not_authed")
+	case len(s.errors[method]) > 0:
+		resp = errorResponse(s.errors[method])
+	default:
+		handler, ok := methods[method]
+		if !ok {
+			resp = errorResponse("unknown_method")
+			break
+		}
+
+		resp = handler(s, params)
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func requestParams(r *http.Request) (map[string]string, error) {
+	params := make(map[string]string)
+	for key := range r.URL.Query() {
+		params[key] = r.URL.Query().Get(key)
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil || len(body) == 0 {
+		return params, err
+	}
+
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return nil, fmt.Errorf("can't parse form: %w", err)
+		}
+
+		for key := range form {
+			params[key] = form.Get(key)
+		}
+
+		return params, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(body, &fields); err != nil {
+		return nil, fmt.Errorf("can't unmarshal body: %w", err)
+	}
+
+	for key, raw := range fields {
+		var str string
+		if json.Unmarshal(raw, &str) == nil {
+			params[key] = str
+			continue
+		}
+
+		params[key] = string(raw)
+	}
+
+	return params, nil
+}
+
+func (s *Server) nextTimestamp() string {
+	s.seq++
+
+	return fmt.Sprintf("%d.%06d", s.epoch, s.seq)
+}
+
+func (s *Server) nextID(prefix string) string {
+	s.seq++
+
+	return fmt.Sprintf("%s%08d", prefix, s.seq)
+}
+
+func (s *Server) addChannel(name string, private bool, creator string) *Channel {
+	ch := &Channel{
+		ID:        fmt.Sprintf("C%08d", len(s.channels)+1),
+		Name:      name,
+		IsChannel: !private,
+		IsPrivate: private,
+		Created:   time.Now().Unix(),
+		Creator:   creator,
+	}
+
+	s.channels = append(s.channels, ch)
+
+	return ch
+}
+
+func (s *Server) findChannel(channel string) *Channel {
+	for _, ch := range s.channels {
+		if ch.ID == channel || ch.Name == strings.TrimPrefix(channel, "#") {
+			return ch
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) findMessage(channel, ts string) *Message {
+	for _, msg := range s.messages[channel] {
+		if msg.Timestamp == ts {
+			return msg
+		}
+	}
+
+	return nil
+}
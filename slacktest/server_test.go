@@ -0,0 +1,276 @@
+package slacktest_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kryabinin/go-slack"
+	"github.com/kryabinin/go-slack/slacktest"
+)
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("messages and threads", func(t *testing.T) {
+		srv := slacktest.NewServer()
+		defer srv.Close()
+
+		channelID := srv.AddChannel("general")
+		c := slack.NewClient("xoxb-test", slack.WithBaseUrl(srv.BaseUrl()))
+
+		posted, err := c.PostMessage(ctx, "hello", "#general")
+		assert.NoError(t, err)
+		assert.True(t, posted.Ok)
+		assert.Equal(t, channelID, posted.Channel)
+
+		_, err = c.SendRequest(ctx, http.MethodPost, "chat.postMessage",
+			[]byte(`{"channel":"`+channelID+`","text":"reply","as_user":true,"thread_ts":"`+posted.Timestamp+`"}`))
+		assert.NoError(t, err)
+
+		messages := srv.Messages("general")
+		assert.Len(t, messages, 2)
+		assert.Equal(t, 1, messages[0].ReplyCount)
+		assert.Equal(t, posted.Timestamp, messages[1].ThreadTimestamp)
+
+		respBody, err := c.SendRequest(ctx, http.MethodGet, "conversations.history?channel="+channelID, nil)
+		assert.NoError(t, err)
+
+		var history struct {
+			Ok       bool `json:"ok"`
+			Messages []struct {
+				Text       string `json:"text"`
+				ReplyCount int    `json:"reply_count"`
+			} `json:"messages"`
+		}
+
+		assert.NoError(t, json.Unmarshal(respBody, &history))
+		assert.True(t, history.Ok)
+		assert.Len(t, history.Messages, 1)
+		assert.Equal(t, "hello", history.Messages[0].Text)
+		assert.Equal(t, 1, history.Messages[0].ReplyCount)
+
+		calls := srv.Calls("chat.postMessage")
+		assert.Len(t, calls, 2)
+		assert.Equal(t, "xoxb-test", calls[1].Token)
+		assert.Equal(t, "reply", calls[1].Params["text"])
+		assert.Equal(t, "true", calls[1].Params["as_user"])
+	})
+
+	t.Run("users", func(t *testing.T) {
+		srv := slacktest.NewServer()
+		defer srv.Close()
+
+		user := slack.User{ID: "U1", Name: "john"}
+		user.Profile.Email = "john@example.com"
+		srv.AddUser(user)
+
+		c := slack.NewClient("xoxb-test", slack.WithBaseUrl(srv.BaseUrl()))
+
+		found, err := c.GetUserByEmail(ctx, "john@example.com")
+		assert.NoError(t, err)
+		assert.Equal(t, "U1", found.ID)
+		assert.Equal(t, slacktest.TeamID, found.TeamID)
+
+		_, err = c.GetUserByEmail(ctx, "jane@example.com")
+		assert.Error(t, err)
+		assert.Equal(t, "slack respond with error: users_not_found", err.Error())
+	})
+
+	t.Run("configured errors", func(t *testing.T) {
+		srv := slacktest.NewServer()
+		defer srv.Close()
+
+		c := slack.NewClient("xoxb-test", slack.WithBaseUrl(srv.BaseUrl()))
+
+		srv.SetError("auth.test", "invalid_auth")
+
+		_, err := c.AuthTest(ctx)
+		assert.Error(t, err)
+		assert.Equal(t, "slack respond with error: invalid_auth", err.Error())
+
+		srv.SetError("auth.test", "")
+
+		info, err := c.AuthTest(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, slacktest.BotUserID, info.UserID)
+	})
+
+	t.Run("team", func(t *testing.T) {
+		srv := slacktest.NewServer()
+		defer srv.Close()
+
+		c := slack.NewClient("xoxb-test", slack.WithBaseUrl(srv.BaseUrl()))
+
+		team, err := c.GetTeamInfo(ctx, "")
+		assert.NoError(t, err)
+		assert.Equal(t, slacktest.TeamID, team.ID)
+
+		_, err = c.GetTeamInfo(ctx, "T1")
+		assert.Equal(t, "slack respond with error: team_not_found", err.Error())
+	})
+
+	t.Run("rate limits", func(t *testing.T) {
+		srv := slacktest.NewServer()
+		defer srv.Close()
+
+		srv.AddChannel("general")
+		srv.RateLimit("chat.postMessage", 1, 3*time.Second)
+
+		c := slack.NewClient("xoxb-test", slack.WithBaseUrl(srv.BaseUrl()))
+
+		_, err := c.PostMessage(ctx, "hello", "general")
+
+		var rateLimited *slack.RateLimitedError
+		assert.True(t, errors.As(err, &rateLimited))
+		assert.Equal(t, 3*time.Second, rateLimited.RetryAfter)
+		assert.Empty(t, srv.Messages("general"))
+	})
+
+	t.Run("unknown method", func(t *testing.T) {
+		srv := slacktest.NewServer()
+		defer srv.Close()
+
+		c := slack.NewClient("xoxb-test", slack.WithBaseUrl(srv.BaseUrl()))
+
+		respBody, err := c.SendRequest(ctx, http.MethodPost, "apps.uninstall", nil)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"ok":false,"error":"unknown_method"}`, string(respBody))
+	})
+	t.Run("reactions and pins", func(t *testing.T) {
+		srv := slacktest.NewServer()
+		defer srv.Close()
+
+		channelID := srv.AddChannel("general")
+		c := slack.NewClient("xoxb-test", slack.WithBaseUrl(srv.BaseUrl()))
+
+		posted, err := c.PostMessage(ctx, "hello", "general")
+		assert.NoError(t, err)
+
+		assert.NoError(t, c.AddReaction(ctx, "thumbsup", posted.Ref()))
+		assert.Equal(t, "slack respond with error: already_reacted",
+			c.AddReaction(ctx, "thumbsup", posted.Ref()).Error())
+
+		item, err := c.GetReactions(ctx, posted.Ref())
+		assert.NoError(t, err)
+		assert.Equal(t, "message", item.Type)
+		assert.Equal(t, []slack.Reaction{{Name: "thumbsup", Count: 1, Users: []string{slacktest.BotUserID}}},
+			item.Message.Reactions)
+
+		reacted, err := c.ListReactions(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, reacted.Items, 1)
+
+		assert.NoError(t, c.RemoveReaction(ctx, "thumbsup", posted.Ref()))
+		assert.Equal(t, "slack respond with error: no_reaction",
+			c.RemoveReaction(ctx, "thumbsup", posted.Ref()).Error())
+
+		assert.NoError(t, c.AddPin(ctx, posted.Ref()))
+
+		pins, err := c.ListPins(ctx, channelID)
+		assert.NoError(t, err)
+		assert.Len(t, pins, 1)
+		assert.Equal(t, "hello", pins[0].Message.Text)
+
+		assert.NoError(t, c.RemovePin(ctx, posted.Ref()))
+		assert.Equal(t, "slack respond with error: no_pin", c.RemovePin(ctx, posted.Ref()).Error())
+	})
+
+	t.Run("bookmarks", func(t *testing.T) {
+		srv := slacktest.NewServer()
+		defer srv.Close()
+
+		channelID := srv.AddChannel("general")
+		c := slack.NewClient("xoxb-test", slack.WithBaseUrl(srv.BaseUrl()))
+
+		bookmark, err := c.AddBookmark(ctx, channelID, "Docs", "https://example.com")
+		assert.NoError(t, err)
+		assert.Equal(t, channelID, bookmark.ChannelID)
+
+		edited, err := c.EditBookmark(ctx, channelID, bookmark.ID, slack.BookmarkTitle("Guide"))
+		assert.NoError(t, err)
+		assert.Equal(t, "Guide", edited.Title)
+		assert.Equal(t, "https://example.com", edited.Link)
+
+		bookmarks, err := c.ListBookmarks(ctx, channelID)
+		assert.NoError(t, err)
+		assert.Equal(t, []slack.Bookmark{edited}, bookmarks)
+
+		assert.NoError(t, c.RemoveBookmark(ctx, channelID, bookmark.ID))
+		assert.Equal(t, "slack respond with error: bookmark_not_found",
+			c.RemoveBookmark(ctx, channelID, bookmark.ID).Error())
+	})
+
+	t.Run("files", func(t *testing.T) {
+		srv := slacktest.NewServer()
+		defer srv.Close()
+
+		channelID := srv.AddChannel("general")
+		c := slack.NewClient("xoxb-test", slack.WithBaseUrl(srv.BaseUrl()))
+
+		files, err := c.UploadFile(ctx, strings.NewReader("content"),
+			slack.FileName("notes.txt"), slack.FileTitle("Notes"), slack.ShareToChannel(channelID))
+		assert.NoError(t, err)
+		assert.Len(t, files, 1)
+		assert.Equal(t, "Notes", files[0].Title)
+		assert.Equal(t, []string{channelID}, files[0].Channels)
+
+		content, ok := srv.FileContent(files[0].ID)
+		assert.True(t, ok)
+		assert.Equal(t, "content", string(content))
+
+		info, err := c.GetFileInfo(ctx, files[0].ID)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), info.Size)
+
+		list, err := c.ListFiles(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, files, list.Files)
+
+		assert.NoError(t, c.DeleteFile(ctx, files[0].ID))
+
+		_, err = c.GetFileInfo(ctx, files[0].ID)
+		assert.Equal(t, "slack respond with error: file_not_found", err.Error())
+	})
+
+	t.Run("views", func(t *testing.T) {
+		srv := slacktest.NewServer()
+		defer srv.Close()
+
+		c := slack.NewClient("xoxb-test", slack.WithBaseUrl(srv.BaseUrl()))
+
+		modal := slack.View{
+			Type:   slack.ViewModal,
+			Title:  slack.PlainText("Form"),
+			Blocks: slack.Blocks{slack.NewDividerBlock()},
+		}
+
+		opened, err := c.OpenView(ctx, "trigger", modal)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, opened.ID)
+		assert.NotEmpty(t, opened.Hash)
+
+		modal.Title = slack.PlainText("Updated")
+
+		updated, err := c.UpdateView(ctx, opened.ID, opened.Hash, modal)
+		assert.NoError(t, err)
+		assert.Equal(t, opened.ID, updated.ID)
+		assert.Equal(t, "Updated", updated.Title.Text)
+
+		_, err = c.UpdateView(ctx, opened.ID, opened.Hash, modal)
+		assert.True(t, errors.Is(err, slack.ErrHashConflict))
+
+		home, err := c.PublishHomeView(ctx, "U1", "", slack.View{Type: slack.ViewHome})
+		assert.NoError(t, err)
+
+		republished, err := c.PublishHomeView(ctx, "U1", home.Hash, slack.View{Type: slack.ViewHome})
+		assert.NoError(t, err)
+		assert.Equal(t, home.ID, republished.ID)
+	})
+}
@@ -0,0 +1,112 @@
+// Package slacktest - views of the fake
+package slacktest
+
+import (
+	"encoding/json"
+
+	"github.com/kryabinin/go-slack"
+)
+
+// view opened modal or published App Home view
+type view struct {
+	slack.View
+
+	userID string
+}
+
+func (s *Server) openView(params map[string]string) response {
+	return s.newModal(params)
+}
+
+func (s *Server) pushView(params map[string]string) response {
+	return s.newModal(params)
+}
+
+func (s *Server) updateView(params map[string]string) response {
+	v, code := parseView(params, "")
+	if len(code) > 0 {
+		return errorResponse(code)
+	}
+
+	for _, existing := range s.views {
+		if existing.ID == params["view_id"] || len(params["view_id"]) == 0 &&
+			len(params["external_id"]) > 0 && existing.ExternalID == params["external_id"] {
+			return s.replaceView(existing, v, params["hash"])
+		}
+	}
+
+	return errorResponse("not_found")
+}
+
+func (s *Server) publishView(params map[string]string) response {
+	if len(params["user_id"]) == 0 {
+		return errorResponse("invalid_arguments")
+	}
+
+	v, code := parseView(params, slack.ViewHome)
+	if len(code) > 0 {
+		return errorResponse(code)
+	}
+
+	for _, existing := range s.views {
+		if existing.userID == params["user_id"] && existing.Type == slack.ViewHome {
+			return s.replaceView(existing, v, params["hash"])
+		}
+	}
+
+	return okResponse(response{"view": s.addView(v, params["user_id"])})
+}
+
+func (s *Server) newModal(params map[string]string) response {
+	if len(params["trigger_id"]) == 0 {
+		return errorResponse("invalid_trigger_id")
+	}
+
+	v, code := parseView(params, slack.ViewModal)
+	if len(code) > 0 {
+		return errorResponse(code)
+	}
+
+	return okResponse(response{"view": s.addView(v, "")})
+}
+
+func (s *Server) addView(v slack.View, userID string) slack.View {
+	v.ID = s.nextID("V")
+	v.TeamID = TeamID
+	v.RootViewID = v.ID
+	v.Hash = s.nextTimestamp()
+
+	s.views = append(s.views, &view{View: v, userID: userID})
+
+	return v
+}
+
+// replaceView replaces content of the view keeping its identity, the hash is checked when it's passed
+func (s *Server) replaceView(existing *view, v slack.View, hash string) response {
+	if len(hash) > 0 && hash != existing.Hash {
+		return errorResponse("hash_conflict")
+	}
+
+	v.ID = existing.ID
+	v.TeamID = existing.TeamID
+	v.RootViewID = existing.RootViewID
+	v.Hash = s.nextTimestamp()
+
+	existing.View = v
+
+	return okResponse(response{"view": v})
+}
+
+// parseView parses the view parameter and checks its type if it's required
+func parseView(params map[string]string, viewType string) (slack.View, string) {
+	var v slack.View
+	if err := json.Unmarshal([]byte(params["view"]), &v); err != nil {
+		return slack.View{}, "invalid_arguments"
+	}
+
+	if len(viewType) > 0 && v.Type != viewType {
+		return slack.View{}, "invalid_arguments"
+	}
+
+	return v, ""
+}
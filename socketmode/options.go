@@ -0,0 +1,77 @@
+// Package socketmode - client options
+package socketmode
+
+import (
+	"context"
+	"time"
+
+	"github.com/kryabinin/go-slack"
+)
+
+type (
+	// Option to use optional parameters in Socket Mode client
+	Option interface {
+		apply(c *Client, clientOpts []slack.ClientOption) []slack.ClientOption
+	}
+
+	withClientOptions struct {
+		opts []slack.ClientOption
+	}
+
+	withBackoff struct {
+		min time.Duration
+		max time.Duration
+	}
+
+	withPingInterval struct {
+		interval time.Duration
+	}
+
+	withErrorHandler struct {
+		handler func(ctx context.Context, err error)
+	}
+)
+
+// WithClientOptions sets options of the api client opening connections, e.g. http client or base url
+func WithClientOptions(opts ...slack.ClientOption) Option {
+	return &withClientOptions{opts: opts}
+}
+
+func (opt *withClientOptions) apply(_ *Client, clientOpts []slack.ClientOption) []slack.ClientOption {
+	return append(clientOpts, opt.opts...)
+}
+
+// WithBackoff sets minimum and maximum delay between reconnection attempts, 1 and 30 seconds by default
+func WithBackoff(min, max time.Duration) Option {
+	return &withBackoff{min: min, max: max}
+}
+
+func (opt *withBackoff) apply(c *Client, clientOpts []slack.ClientOption) []slack.ClientOption {
+	c.minBackoff = opt.min
+	c.maxBackoff = opt.max
+
+	return clientOpts
+}
+
+// WithPingInterval sets interval of pings keeping the connection alive, 10 seconds by default. The client reconnects
+// when nothing is received for three intervals
+func WithPingInterval(interval time.Duration) Option {
+	return &withPingInterval{interval: interval}
+}
+
+func (opt *withPingInterval) apply(c *Client, clientOpts []slack.ClientOption) []slack.ClientOption {
+	c.pingInterval = opt.interval
+
+	return clientOpts
+}
+
+// WithErrorHandler sets handler of connection and envelope handling errors
+func WithErrorHandler(handler func(ctx context.Context, err error)) Option {
+	return &withErrorHandler{handler: handler}
+}
+
+func (opt *withErrorHandler) apply(c *Client, clientOpts []slack.ClientOption) []slack.ClientOption {
+	c.onError = opt.handler
+
+	return clientOpts
+}
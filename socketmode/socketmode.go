@@ -0,0 +1,454 @@
+// Package socketmode - Socket Mode client
+package socketmode
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"golang.org/x/net/websocket"
+
+	"github.com/kryabinin/go-slack"
+	"github.com/kryabinin/go-slack/events"
+)
+
+const (
+	// TypeHello type of the message sent by slack when the connection is established
+	TypeHello = "hello"
+
+	// TypeDisconnect type of the message sent by slack before closing the connection
+	TypeDisconnect = "disconnect"
+
+	// TypeEventsAPI type of the envelope with an Events API callback
+	TypeEventsAPI = "events_api"
+
+	// TypeInteractive type of the envelope with an interactivity payload
+	TypeInteractive = "interactive"
+
+	// TypeSlashCommands type of the envelope with a slash command
+	TypeSlashCommands = "slash_commands"
+
+	reasonLinkDisabled = "link_disabled"
+	origin             = "https://slack.com"
+	defaultMinBackoff  = time.Second
+	defaultMaxBackoff  = 30 * time.Second
+
+	// defaultPingInterval interval of pings keeping the connection alive, the connection is considered lost when
+	// nothing is received for pingTimeouts intervals
+	defaultPingInterval = 10 * time.Second
+	pingTimeouts        = 3
+)
+
+var (
+	// ErrLinkDisabled Socket Mode is disabled for the app
+	ErrLinkDisabled = errors.New("socket mode is disabled for the app")
+
+	errReconnect = errors.New("reconnect requested")
+
+	errConnectionClosed = errors.New("connection is closed")
+
+	pingCodec = websocket.Codec{Marshal: func(interface{}) ([]byte, byte, error) {
+		return nil, websocket.PingFrame, nil
+	}}
+)
+
+type (
+	// Request message received over the Socket Mode connection
+	Request struct {
+		// Type type of the message
+		Type string `json:"type"`
+
+		// EnvelopeID identifier to acknowledge the envelope with
+		EnvelopeID string `json:"envelope_id"`
+
+		// Payload payload of the envelope: event callback, interactivity payload or slash command
+		Payload json.RawMessage `json:"payload"`
+
+		// AcceptsResponsePayload true if the acknowledgement can contain a response payload
+		AcceptsResponsePayload bool `json:"accepts_response_payload"`
+
+		// RetryAttempt number of the delivery retry
+		RetryAttempt int `json:"retry_attempt"`
+
+		// RetryReason reason of the delivery retry
+		RetryReason string `json:"retry_reason"`
+
+		// Reason reason of disconnect message: warning, refresh_requested or link_disabled
+		Reason string `json:"reason"`
+	}
+
+	// HandlerFunc handles an envelope. The returned payload is sent with the acknowledgement if the envelope accepts
+	// a response payload
+	HandlerFunc func(ctx context.Context, req Request) (interface{}, error)
+
+	// Client Socket Mode client. Events API envelopes are routed by the embedded router, other envelopes by handlers
+	// registered for their types
+	Client struct {
+		*events.Router
+
+		api          slack.Client
+		minBackoff   time.Duration
+		maxBackoff   time.Duration
+		pingInterval time.Duration
+		onError      func(ctx context.Context, err error)
+
+		mu       sync.RWMutex
+		handlers map[string]HandlerFunc
+	}
+
+	// connection websocket connection acknowledging envelopes until it's closed. Handlers finished after a reconnect
+	// don't write to the closed connection, slack redelivers their envelopes to the new one
+	connection struct {
+		mu     sync.Mutex
+		ws     *websocket.Conn
+		closed bool
+	}
+
+	// deadlineConn network connection extending the read deadline on every read, including pings and pongs handled
+	// by the websocket itself, so a half-open connection fails instead of blocking forever
+	deadlineConn struct {
+		net.Conn
+		timeout time.Duration
+	}
+
+	ack struct {
+		EnvelopeID string      `json:"envelope_id"`
+		Payload    interface{} `json:"payload,omitempty"`
+	}
+
+	connectionApiResponse struct {
+		Ok    bool   `json:"ok"`
+		Error string `json:"error"`
+		Url   string `json:"url"`
+	}
+)
+
+// New is Socket Mode client constructor, the app-level token starts with xapp-
+func New(appToken string, opts ...Option) *Client {
+	c := &Client{
+		Router:       events.NewRouter(),
+		minBackoff:   defaultMinBackoff,
+		maxBackoff:   defaultMaxBackoff,
+		pingInterval: defaultPingInterval,
+		onError:      func(context.Context, error) {},
+		handlers:     make(map[string]HandlerFunc),
+	}
+
+	var clientOpts []slack.ClientOption
+	for _, opt := range opts {
+		clientOpts = opt.apply(c, clientOpts)
+	}
+
+	c.api = slack.NewClient(appToken, clientOpts...)
+
+	return c
+}
+
+// Handle registers handler of envelopes of the type, e.g. TypeInteractive or TypeSlashCommands
+func (c *Client) Handle(requestType string, handler HandlerFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.handlers[requestType] = handler
+}
+
+// Run connects to slack and handles envelopes until the context is done. Reconnects with exponential backoff when
+// the connection is lost. Returns ErrLinkDisabled if Socket Mode is disabled for the app
+func (c *Client) Run(ctx context.Context) error {
+	backoff := c.minBackoff
+
+	for {
+		hello, err := c.connect(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if errors.Is(err, ErrLinkDisabled) {
+			return err
+		}
+
+		if hello {
+			backoff = c.minBackoff
+		}
+
+		if errors.Is(err, errReconnect) {
+			continue
+		}
+
+		c.onError(ctx, err)
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		if backoff *= 2; backoff > c.maxBackoff {
+			backoff = c.maxBackoff
+		}
+	}
+}
+
+func (c *Client) connect(ctx context.Context) (bool, error) {
+	url, err := c.openConnection(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	// the url contains a one-time ticket, it's not included in errors
+	ws, err := dial(ctx, url, pingTimeouts*c.pingInterval)
+	if err != nil {
+		return false, fmt.Errorf("can't dial: %w", err)
+	}
+
+	conn := &connection{ws: ws}
+	defer conn.close()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		ticker := time.NewTicker(c.pingInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				conn.close()
+				return
+			case <-done:
+				return
+			case <-ticker.C:
+				if err := conn.ping(); err != nil && !errors.Is(err, errConnectionClosed) {
+					c.onError(ctx, fmt.Errorf("can't send ping: %w", err))
+				}
+			}
+		}
+	}()
+
+	hello := false
+	for {
+		var data []byte
+		if err = websocket.Message.Receive(conn.ws, &data); err != nil {
+			return hello, fmt.Errorf("can't receive message: %w", err)
+		}
+
+		var req Request
+		if err = json.Unmarshal(data, &req); err != nil {
+			c.onError(ctx, fmt.Errorf("can't unmarshal message: %w", err))
+			continue
+		}
+
+		switch req.Type {
+		case TypeHello:
+			hello = true
+		case TypeDisconnect:
+			if req.Reason == reasonLinkDisabled {
+				return hello, ErrLinkDisabled
+			}
+
+			return hello, errReconnect
+		default:
+			if len(req.EnvelopeID) > 0 {
+				go c.handle(ctx, conn, req)
+			}
+		}
+	}
+}
+
+// dial opens a websocket connection, dialing and the handshake are aborted when the context is done. Reads fail when
+// nothing is received for the timeout
+func dial(ctx context.Context, rawURL string, timeout time.Duration) (*websocket.Conn, error) {
+	config, err := websocket.NewConfig(rawURL, origin)
+	if err != nil {
+		return nil, errors.New("invalid url")
+	}
+
+	var (
+		netConn net.Conn
+		dialer  = &net.Dialer{}
+	)
+
+	switch config.Location.Scheme {
+	case "ws":
+		netConn, err = dialer.DialContext(ctx, "tcp", hostPort(config.Location, "80"))
+	case "wss":
+		tlsDialer := &tls.Dialer{NetDialer: dialer, Config: config.TlsConfig}
+		netConn, err = tlsDialer.DialContext(ctx, "tcp", hostPort(config.Location, "443"))
+	default:
+		return nil, fmt.Errorf("unsupported scheme %s", config.Location.Scheme)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	handshaked := make(chan struct{})
+	defer close(handshaked)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			netConn.Close()
+		case <-handshaked:
+		}
+	}()
+
+	if err = netConn.SetReadDeadline(time.Now().Add(timeout)); err != nil {
+		netConn.Close()
+		return nil, err
+	}
+
+	ws, err := websocket.NewClient(config, &deadlineConn{Conn: netConn, timeout: timeout})
+	if err != nil {
+		netConn.Close()
+
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		return nil, err
+	}
+
+	return ws, nil
+}
+
+// Read implementation
+func (c *deadlineConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if n > 0 {
+		_ = c.Conn.SetReadDeadline(time.Now().Add(c.timeout))
+	}
+
+	return n, err
+}
+
+func hostPort(u *url.URL, defaultPort string) string {
+	if len(u.Port()) > 0 {
+		return u.Host
+	}
+
+	return net.JoinHostPort(u.Hostname(), defaultPort)
+}
+
+func (c *Client) openConnection(ctx context.Context) (string, error) {
+	respBody, err := c.api.SendRequest(ctx, http.MethodPost, "apps.connections.open", nil)
+	if err != nil {
+		return "", err
+	}
+
+	var resp connectionApiResponse
+	if err = json.Unmarshal(respBody, &resp); err != nil {
+		return "", fmt.Errorf("can't unmarshal response: %w", err)
+	}
+
+	if !resp.Ok {
+		return "", fmt.Errorf("slack respond with error: %s", resp.Error)
+	}
+
+	return resp.Url, nil
+}
+
+func (c *Client) handle(ctx context.Context, conn *connection, req Request) {
+	if req.Type == TypeEventsAPI {
+		c.send(ctx, conn, ack{EnvelopeID: req.EnvelopeID})
+
+		callback, err := events.ParseCallback(req.Payload)
+		if err != nil {
+			c.onError(ctx, err)
+			return
+		}
+
+		callback.RetryNum = req.RetryAttempt
+		callback.RetryReason = req.RetryReason
+
+		if err = c.Dispatch(ctx, callback); err != nil {
+			c.onError(ctx, err)
+		}
+
+		return
+	}
+
+	c.mu.RLock()
+	handler, ok := c.handlers[req.Type]
+	c.mu.RUnlock()
+
+	if !ok {
+		c.send(ctx, conn, ack{EnvelopeID: req.EnvelopeID})
+		return
+	}
+
+	payload, err := call(ctx, handler, req)
+	if err != nil {
+		c.onError(ctx, err)
+	}
+
+	if !req.AcceptsResponsePayload {
+		payload = nil
+	}
+
+	c.send(ctx, conn, ack{EnvelopeID: req.EnvelopeID, Payload: payload})
+}
+
+func (c *Client) send(ctx context.Context, conn *connection, ack ack) {
+	if err := conn.send(ack); err != nil {
+		c.onError(ctx, fmt.Errorf("can't acknowledge envelope %s: %w", ack.EnvelopeID, err))
+	}
+}
+
+// send writes the ack unless the connection is closed. Writes are serialized by the websocket, a write pending when
+// the connection is closed fails
+func (conn *connection) send(ack ack) error {
+	conn.mu.Lock()
+	closed := conn.closed
+	conn.mu.Unlock()
+
+	if closed {
+		return errConnectionClosed
+	}
+
+	return websocket.JSON.Send(conn.ws, ack)
+}
+
+// ping sends a ping frame, slack answers with a pong extending the read deadline
+func (conn *connection) ping() error {
+	conn.mu.Lock()
+	closed := conn.closed
+	conn.mu.Unlock()
+
+	if closed {
+		return errConnectionClosed
+	}
+
+	return pingCodec.Send(conn.ws, nil)
+}
+
+func (conn *connection) close() {
+	conn.mu.Lock()
+	closed := conn.closed
+	conn.closed = true
+	conn.mu.Unlock()
+
+	if !closed {
+		conn.ws.Close()
+	}
+}
+
+func call(ctx context.Context, handler HandlerFunc, req Request) (payload interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			payload, err = nil, fmt.Errorf("envelope handler panic: %v", r)
+		}
+	}()
+
+	return handler(ctx, req)
+}
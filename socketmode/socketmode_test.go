@@ -0,0 +1,285 @@
+package socketmode_test
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/websocket"
+
+	"github.com/kryabinin/go-slack"
+	"github.com/kryabinin/go-slack/events"
+	"github.com/kryabinin/go-slack/socketmode"
+)
+
+// server is a stand-in for slack: serves apps.connections.open and runs the scenario of the n-th connection
+func server(t *testing.T, scenarios ...func(conn *websocket.Conn)) (*httptest.Server, *int32) {
+	var opened, connected int32
+
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+
+	mux.HandleFunc("/api/apps.connections.open", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer xapp-test", r.Header.Get("Authorization"))
+
+		if atomic.AddInt32(&opened, 1) == 1 && len(scenarios) == 0 {
+			_, _ = w.Write([]byte(`{"ok":false,"error":"internal_error"}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"ok":true,"url":"ws` + strings.TrimPrefix(srv.URL, "http") + `/link"}`))
+	})
+
+	mux.Handle("/link", websocket.Handler(func(conn *websocket.Conn) {
+		n := int(atomic.AddInt32(&connected, 1)) - 1
+		if n >= len(scenarios) {
+			send(t, conn, `{"type":"disconnect","reason":"link_disabled"}`)
+			return
+		}
+
+		scenarios[n](conn)
+	}))
+
+	return srv, &opened
+}
+
+func send(t *testing.T, conn *websocket.Conn, msg string) {
+	assert.NoError(t, websocket.Message.Send(conn, msg))
+}
+
+func receive(t *testing.T, conn *websocket.Conn) map[string]interface{} {
+	var ack map[string]interface{}
+	assert.NoError(t, websocket.JSON.Receive(conn, &ack))
+
+	return ack
+}
+
+func TestClient_Run(t *testing.T) {
+	t.Run("dispatches envelopes and reconnects", func(t *testing.T) {
+		received := make(chan events.Callback, 1)
+
+		srv, opened := server(t, func(conn *websocket.Conn) {
+			send(t, conn, `{"type":"hello"}`)
+
+			send(t, conn, `{"type":"events_api","envelope_id":"e1","retry_attempt":1,"retry_reason":"timeout",`+
+				`"payload":{"type":"event_callback","team_id":"T1","event_id":"Ev1",`+
+				`"event":{"type":"app_mention","user":"U1","text":"hi","channel":"C1"}}}`)
+			assert.Equal(t, map[string]interface{}{"envelope_id": "e1"}, receive(t, conn))
+
+			send(t, conn, `{"type":"slash_commands","envelope_id":"e2","accepts_response_payload":true,`+
+				`"payload":{"command":"/echo","text":"hello"}}`)
+			assert.Equal(t, map[string]interface{}{
+				"envelope_id": "e2",
+				"payload":     map[string]interface{}{"text": "hello"},
+			}, receive(t, conn))
+
+			send(t, conn, `{"type":"interactive","envelope_id":"e3","payload":{}}`)
+			assert.Equal(t, map[string]interface{}{"envelope_id": "e3"}, receive(t, conn))
+
+			send(t, conn, `{"type":"disconnect","reason":"refresh_requested"}`)
+		})
+		defer srv.Close()
+
+		c := socketmode.New("xapp-test", socketmode.WithClientOptions(slack.WithBaseUrl(srv.URL+"/api")))
+
+		c.On("app_mention", func(ctx context.Context, callback events.Callback) error {
+			received <- callback
+			return nil
+		})
+
+		c.Handle(socketmode.TypeSlashCommands, func(ctx context.Context, req socketmode.Request) (interface{}, error) {
+			var cmd struct {
+				Text string `json:"text"`
+			}
+
+			assert.NoError(t, json.Unmarshal(req.Payload, &cmd))
+
+			return map[string]string{"text": cmd.Text}, nil
+		})
+
+		assert.Equal(t, socketmode.ErrLinkDisabled, c.Run(context.Background()))
+		assert.Equal(t, int32(2), atomic.LoadInt32(opened))
+
+		callback := <-received
+		assert.Equal(t, "Ev1", callback.EventID)
+		assert.Equal(t, 1, callback.RetryNum)
+		assert.Equal(t, "timeout", callback.RetryReason)
+		assert.Equal(t, &events.AppMentionEvent{Type: "app_mention", User: "U1", Text: "hi", Channel: "C1"}, callback.Event)
+	})
+
+	t.Run("retries failed connection with backoff", func(t *testing.T) {
+		srv, opened := server(t)
+		defer srv.Close()
+
+		var errs []error
+		c := socketmode.New("xapp-test",
+			socketmode.WithClientOptions(slack.WithBaseUrl(srv.URL+"/api")),
+			socketmode.WithBackoff(time.Millisecond, 10*time.Millisecond),
+			socketmode.WithErrorHandler(func(ctx context.Context, err error) {
+				errs = append(errs, err)
+			}),
+		)
+
+		assert.Equal(t, socketmode.ErrLinkDisabled, c.Run(context.Background()))
+		assert.Equal(t, int32(2), atomic.LoadInt32(opened))
+		assert.Len(t, errs, 1)
+		assert.Equal(t, "slack respond with error: internal_error", errs[0].Error())
+	})
+
+	t.Run("stops on context cancel", func(t *testing.T) {
+		srv, _ := server(t, func(conn *websocket.Conn) {
+			send(t, conn, `{"type":"hello"}`)
+
+			var msg []byte
+			_ = websocket.Message.Receive(conn, &msg)
+		})
+		defer srv.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		c := socketmode.New("xapp-test", socketmode.WithClientOptions(slack.WithBaseUrl(srv.URL+"/api")))
+
+		assert.Equal(t, context.DeadlineExceeded, c.Run(ctx))
+	})
+	t.Run("dial is aborted on context cancel", func(t *testing.T) {
+		// the listener accepts connections but never answers the handshake
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		assert.NoError(t, err)
+		defer listener.Close()
+
+		go func() {
+			for {
+				conn, err := listener.Accept()
+				if err != nil {
+					return
+				}
+				defer conn.Close()
+			}
+		}()
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"ok":true,"url":"ws://` + listener.Addr().String() + `/link"}`))
+		}))
+		defer srv.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		c := socketmode.New("xapp-test", socketmode.WithClientOptions(slack.WithBaseUrl(srv.URL+"/api")))
+
+		done := make(chan error, 1)
+		go func() { done <- c.Run(ctx) }()
+
+		select {
+		case err := <-done:
+			assert.Equal(t, context.DeadlineExceeded, err)
+		case <-time.After(time.Second):
+			t.Fatal("dial is not aborted")
+		}
+	})
+
+	t.Run("envelopes are not acknowledged on a closed connection", func(t *testing.T) {
+		release := make(chan struct{})
+
+		srv, _ := server(t, func(conn *websocket.Conn) {
+			send(t, conn, `{"type":"hello"}`)
+			send(t, conn, `{"type":"interactive","envelope_id":"e1","payload":{}}`)
+			send(t, conn, `{"type":"disconnect","reason":"refresh_requested"}`)
+		}, func(conn *websocket.Conn) {
+			send(t, conn, `{"type":"hello"}`)
+			close(release)
+
+			var msg []byte
+			assert.NoError(t, conn.SetReadDeadline(time.Now().Add(50*time.Millisecond)))
+			assert.Error(t, websocket.Message.Receive(conn, &msg))
+
+			send(t, conn, `{"type":"disconnect","reason":"link_disabled"}`)
+		})
+		defer srv.Close()
+
+		var (
+			mu   sync.Mutex
+			errs []string
+		)
+
+		c := socketmode.New("xapp-test",
+			socketmode.WithClientOptions(slack.WithBaseUrl(srv.URL+"/api")),
+			socketmode.WithErrorHandler(func(ctx context.Context, err error) {
+				mu.Lock()
+				errs = append(errs, err.Error())
+				mu.Unlock()
+			}),
+		)
+
+		c.Handle(socketmode.TypeInteractive, func(ctx context.Context, req socketmode.Request) (interface{}, error) {
+			<-release
+			return nil, nil
+		})
+
+		assert.Equal(t, socketmode.ErrLinkDisabled, c.Run(context.Background()))
+
+		// the ack is sent asynchronously after the handler returns
+		expErr := "can't acknowledge envelope e1: connection is closed"
+		for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(5 * time.Millisecond) {
+			found := false
+
+			mu.Lock()
+			for _, err := range errs {
+				found = found || err == expErr
+			}
+			mu.Unlock()
+
+			if found {
+				break
+			}
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		assert.Contains(t, errs, expErr)
+	})
+	t.Run("reconnects when the connection is silent", func(t *testing.T) {
+		release := make(chan struct{})
+
+		// the first connection never reads, so pings are not answered
+		srv, opened := server(t, func(conn *websocket.Conn) {
+			send(t, conn, `{"type":"hello"}`)
+			<-release
+		})
+		defer srv.Close()
+		defer close(release)
+
+		var errs int32
+		c := socketmode.New("xapp-test",
+			socketmode.WithClientOptions(slack.WithBaseUrl(srv.URL+"/api")),
+			socketmode.WithBackoff(time.Millisecond, 10*time.Millisecond),
+			socketmode.WithPingInterval(10*time.Millisecond),
+			socketmode.WithErrorHandler(func(ctx context.Context, err error) {
+				atomic.AddInt32(&errs, 1)
+			}),
+		)
+
+		done := make(chan error, 1)
+		go func() { done <- c.Run(context.Background()) }()
+
+		select {
+		case err := <-done:
+			assert.Equal(t, socketmode.ErrLinkDisabled, err)
+		case <-time.After(time.Second):
+			t.Fatal("silent connection is not reconnected")
+		}
+
+		assert.Equal(t, int32(2), atomic.LoadInt32(opened))
+		assert.NotZero(t, atomic.LoadInt32(&errs))
+	})
+}
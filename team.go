@@ -0,0 +1,210 @@
+// Package slack - team
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// unscopedMethods methods of the token rather than a workspace, team_id isn't added to them
+var unscopedMethods = map[string]bool{
+	"auth.test":       true,
+	"auth.teams.list": true,
+}
+
+type (
+	// Team workspace entity
+	Team struct {
+		// ID workspace identifier
+		ID string `json:"id"`
+
+		// Name workspace name
+		Name string `json:"name"`
+
+		// Domain subdomain of the workspace url
+		Domain string `json:"domain"`
+
+		// EmailDomain email domain allowed to sign up to the workspace
+		EmailDomain string `json:"email_domain"`
+
+		// Icon workspace icons
+		Icon TeamIcon `json:"icon"`
+
+		// EnterpriseID Enterprise Grid organization identifier, empty for standalone workspaces
+		EnterpriseID string `json:"enterprise_id"`
+
+		// EnterpriseName Enterprise Grid organization name
+		EnterpriseName string `json:"enterprise_name"`
+	}
+
+	// TeamIcon workspace icons of different sizes
+	TeamIcon struct {
+		// Image34 url of 34px icon
+		Image34 string `json:"image_34"`
+
+		// Image44 url of 44px icon
+		Image44 string `json:"image_44"`
+
+		// Image68 url of 68px icon
+		Image68 string `json:"image_68"`
+
+		// Image88 url of 88px icon
+		Image88 string `json:"image_88"`
+
+		// Image102 url of 102px icon
+		Image102 string `json:"image_102"`
+
+		// Image132 url of 132px icon
+		Image132 string `json:"image_132"`
+
+		// ImageDefault true if the workspace has no custom icon
+		ImageDefault bool `json:"image_default"`
+	}
+
+	// TeamsList page of workspaces
+	TeamsList struct {
+		// Teams list of workspaces
+		Teams []Team `json:"teams"`
+
+		// ResponseMetadata pagination information
+		ResponseMetadata ResponseMetadata `json:"response_metadata"`
+	}
+
+	teamApiResponse struct {
+		// Ok indicates success or failure
+		Ok bool `json:"ok"`
+
+		// Error short machine-readable error code
+		Error string `json:"error"`
+
+		// Team workspace of team.info
+		Team Team `json:"team"`
+
+		TeamsList
+	}
+)
+
+// ForTeam returns a copy of the client scoped to the workspace, see WithTeam. Clients not created by NewClient,
+// e.g. fakes, are returned as is
+func ForTeam(c Client, teamID string) Client {
+	orig, ok := c.(*client)
+	if !ok {
+		return c
+	}
+
+	// the token info cache is shared by pointer, the copy uses the same token
+	cp := *orig
+	cp.teamID = teamID
+	cp.roundTrip = chain(cp.middlewares, cp.do)
+
+	return &cp
+}
+
+func getTeamInfo(ctx context.Context, c *client, teamID string) (Team, error) {
+	params := url.Values{}
+	if len(teamID) > 0 {
+		params.Set("team", teamID)
+	}
+
+	respBody, err := c.get(ctx, "team.info?"+params.Encode())
+	if err != nil {
+		return Team{}, err
+	}
+
+	resp, err := decodeTeamResponse(respBody)
+	if err != nil {
+		return Team{}, err
+	}
+
+	return resp.Team, nil
+}
+
+func listAuthTeams(ctx context.Context, c *client, opts ...ListOption) (TeamsList, error) {
+	respBody, err := c.get(ctx, "auth.teams.list?"+listParams(opts).Encode())
+	if err != nil {
+		return TeamsList{}, err
+	}
+
+	resp, err := decodeTeamResponse(respBody)
+	if err != nil {
+		return TeamsList{}, err
+	}
+
+	return resp.TeamsList, nil
+}
+
+func decodeTeamResponse(respBody []byte) (teamApiResponse, error) {
+	var resp teamApiResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return teamApiResponse{}, fmt.Errorf("can't unmarshal response: %w", err)
+	}
+
+	if !resp.Ok {
+		return teamApiResponse{}, fmt.Errorf("slack respond with error: %s", resp.Error)
+	}
+
+	return resp, nil
+}
+
+// scopeToTeam adds team_id of the client to the request of a web api method unless the request already has it.
+// Token level methods and absolute urls, e.g. of file transfers, are sent as is
+func scopeToTeam(teamID, contentType, method, path string, data []byte) (string, []byte, error) {
+	if len(teamID) == 0 || strings.Contains(path, "://") || unscopedMethods[strings.SplitN(path, "?", 2)[0]] {
+		return path, data, nil
+	}
+
+	trimmed := bytes.TrimSpace(data)
+	switch {
+	case method == http.MethodGet || (len(trimmed) == 0 && contentType != contentTypeForm):
+		base, query := path, ""
+		if i := strings.Index(path, "?"); i >= 0 {
+			base, query = path[:i], path[i+1:]
+		}
+
+		params, err := url.ParseQuery(query)
+		if err != nil {
+			return "", nil, fmt.Errorf("can't parse query: %w", err)
+		}
+
+		if len(params.Get("team_id")) == 0 {
+			params.Set("team_id", teamID)
+		}
+
+		return base + "?" + params.Encode(), data, nil
+	case contentType == contentTypeJSON:
+		// only json objects can be scoped, other bodies are sent as is
+		fields := map[string]json.RawMessage{}
+		if err := json.Unmarshal(trimmed, &fields); err != nil || fields == nil {
+			return path, data, nil
+		}
+
+		if _, ok := fields["team_id"]; ok {
+			return path, data, nil
+		}
+
+		fields["team_id"], _ = json.Marshal(teamID)
+
+		scoped, err := json.Marshal(fields)
+		if err != nil {
+			return "", nil, fmt.Errorf("can't marshal request: %w", err)
+		}
+
+		return path, scoped, nil
+	default:
+		params, err := url.ParseQuery(string(trimmed))
+		if err != nil {
+			return "", nil, fmt.Errorf("can't parse form: %w", err)
+		}
+
+		if len(params.Get("team_id")) == 0 {
+			params.Set("team_id", teamID)
+		}
+
+		return path, []byte(params.Encode()), nil
+	}
+}
@@ -0,0 +1,195 @@
+package slack_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-slack"
+)
+
+func TestClient_GetTeamInfo(t *testing.T) {
+	t.Run("positive case", func(t *testing.T) {
+		baseUrl := "http://test.slack.com/api"
+
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Equal(t, baseUrl+"/"+"team.info?team=T1", req.URL.String())
+		}).Return(testResponse(`{"ok":true,"team":{"id":"T1","name":"Acme","domain":"acme",`+
+			`"icon":{"image_34":"https://a.slack-edge.com/34.png"},"enterprise_id":"E1","enterprise_name":"Acme Org"}}`,
+			http.StatusOK), nil)
+
+		c := slack.NewClient("test_token", slack.WithBaseUrl(baseUrl), slack.WithHttpClient(httpClient))
+
+		team, err := c.GetTeamInfo(context.Background(), "T1")
+		assert.NoError(t, err)
+		assert.Equal(t, slack.Team{
+			ID:             "T1",
+			Name:           "Acme",
+			Domain:         "acme",
+			Icon:           slack.TeamIcon{Image34: "https://a.slack-edge.com/34.png"},
+			EnterpriseID:   "E1",
+			EnterpriseName: "Acme Org",
+		}, team)
+	})
+
+	t.Run("slack respond with error", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).
+			Return(testResponse(`{"ok":false,"error":"team_not_found"}`, http.StatusOK), nil)
+
+		c := slack.NewClient("test_token", slack.WithHttpClient(httpClient))
+
+		team, err := c.GetTeamInfo(context.Background(), "T1")
+		assert.Equal(t, "slack respond with error: team_not_found", err.Error())
+		assert.Equal(t, slack.Team{}, team)
+	})
+}
+
+func TestClient_ListAuthTeams(t *testing.T) {
+	baseUrl := "http://test.slack.com/api"
+
+	httpClient := new(slack.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, baseUrl+"/"+"auth.teams.list?cursor=abc&limit=2", req.URL.String())
+	}).Return(testResponse(`{"ok":true,"teams":[{"id":"T1","name":"Acme"},{"id":"T2","name":"Acme Labs"}],`+
+		`"response_metadata":{"next_cursor":"def"}}`, http.StatusOK), nil)
+
+	c := slack.NewClient("test_token", slack.WithBaseUrl(baseUrl), slack.WithHttpClient(httpClient))
+
+	teams, err := c.ListAuthTeams(context.Background(), slack.Cursor("abc"), slack.Limit(2))
+	assert.NoError(t, err)
+	assert.Equal(t, []slack.Team{{ID: "T1", Name: "Acme"}, {ID: "T2", Name: "Acme Labs"}}, teams.Teams)
+	assert.Equal(t, "def", teams.ResponseMetadata.NextCursor)
+}
+
+func TestWithTeam(t *testing.T) {
+	baseUrl := "http://test.slack.com/api"
+
+	t.Run("json body", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			request, err := ioutil.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"channel":"C1","timestamp":"1.2","name":"eyes","team_id":"T1"}`, string(request))
+		}).Return(testResponse(`{"ok":true}`, http.StatusOK), nil)
+
+		c := slack.NewClient("test_token", slack.WithHttpClient(httpClient), slack.WithTeam("T1"))
+
+		assert.NoError(t, c.AddReaction(context.Background(), "eyes", slack.MessageRef{Channel: "C1", Timestamp: "1.2"}))
+	})
+
+	t.Run("json body with team", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			request, err := ioutil.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"channel":"C1","team_id":"T2"}`, string(request))
+		}).Return(testResponse(`{"ok":true}`, http.StatusOK), nil)
+
+		c := slack.NewClient("test_token", slack.WithHttpClient(httpClient), slack.WithTeam("T1"))
+
+		_, err := c.SendRequest(context.Background(), http.MethodPost, "conversations.create",
+			[]byte(`{"channel":"C1","team_id":"T2"}`))
+		assert.NoError(t, err)
+	})
+
+	t.Run("query", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, baseUrl+"/"+"pins.list?channel=C1&team_id=T1", req.URL.String())
+		}).Return(testResponse(`{"ok":true}`, http.StatusOK), nil)
+
+		c := slack.NewClient("test_token", slack.WithBaseUrl(baseUrl), slack.WithHttpClient(httpClient),
+			slack.WithTeam("T1"))
+
+		_, err := c.ListPins(context.Background(), "C1")
+		assert.NoError(t, err)
+	})
+
+	t.Run("form body", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			request, err := ioutil.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, "channel=C1&team_id=T1", string(request))
+		}).Return(testResponse(`{"ok":true,"channel":{"id":"C1"}}`, http.StatusOK), nil)
+
+		c := slack.NewClient("test_token", slack.WithHttpClient(httpClient), slack.WithTeam("T1"))
+
+		_, err := slack.Call[map[string]string, testConversationInfoResp](context.Background(), c,
+			"conversations.info", map[string]string{"channel": "C1"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("derived client", func(t *testing.T) {
+		var urls []string
+
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			urls = append(urls, req.URL.String())
+		}).Return(testResponse(`{"ok":true}`, http.StatusOK), nil).Once()
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			urls = append(urls, req.URL.String())
+		}).Return(testResponse(`{"ok":true}`, http.StatusOK), nil).Once()
+
+		c := slack.NewClient("test_token", slack.WithBaseUrl(baseUrl), slack.WithHttpClient(httpClient))
+
+		_, err := slack.ForTeam(c, "T2").ListPins(context.Background(), "C1")
+		assert.NoError(t, err)
+
+		_, err = c.ListPins(context.Background(), "C1")
+		assert.NoError(t, err)
+
+		assert.Equal(t, []string{baseUrl + "/pins.list?channel=C1&team_id=T2", baseUrl + "/pins.list?channel=C1"}, urls)
+	})
+	t.Run("token level methods", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, baseUrl+"/"+"auth.test", req.URL.String())
+		}).Return(testResponse(`{"ok":true,"team_id":"T1"}`, http.StatusOK), nil).Once()
+
+		c := slack.NewClient("xoxb-test", slack.WithBaseUrl(baseUrl), slack.WithHttpClient(httpClient))
+
+		info, err := slack.ForTeam(c, "T2").TokenInfo(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "T1", info.TeamID)
+
+		// the token info is shared with the derived client
+		_, err = c.TokenInfo(context.Background())
+		assert.NoError(t, err)
+		httpClient.AssertNumberOfCalls(t, "Do", 1)
+	})
+}
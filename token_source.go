@@ -0,0 +1,229 @@
+// Package slack - token sources
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const (
+	tokenExpiredError = "token_expired"
+	refreshMargin     = 5 * time.Minute
+)
+
+type (
+	// TokenSource provides tokens to authorize requests
+	TokenSource interface {
+		// Token returns a valid token
+		Token(ctx context.Context) (string, error)
+	}
+
+	// RefreshableTokenSource token source able to replace an expired token. Requests failed with token_expired error
+	// are retried once with the refreshed token
+	RefreshableTokenSource interface {
+		TokenSource
+
+		// Refresh replaces the expired token and returns the new one. Does nothing if the expired token is already
+		// replaced
+		Refresh(ctx context.Context, expired string) (string, error)
+	}
+
+	// RotatingToken token issued with token rotation enabled
+	RotatingToken struct {
+		// AccessToken expiring access token, starts with xoxe.xoxb- or xoxe.xoxp-
+		AccessToken string `json:"access_token"`
+
+		// RefreshToken token to issue a new access token, starts with xoxe-
+		RefreshToken string `json:"refresh_token"`
+
+		// ExpiresAt expiration time of the access token
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+
+	// RotatingTokenSource token source refreshing expiring tokens with oauth.v2.access method. Safe for concurrent use
+	RotatingTokenSource struct {
+		mu           sync.Mutex
+		clientID     string
+		clientSecret string
+		token        RotatingToken
+		onRefresh    func(ctx context.Context, token RotatingToken) error
+		client       *client
+		now          func() time.Time
+	}
+
+	staticToken string
+
+	refreshApiResponse struct {
+		// Ok indicates success or failure
+		Ok bool `json:"ok"`
+
+		// Error short machine-readable error code
+		Error string `json:"error"`
+
+		// AccessToken new access token
+		AccessToken string `json:"access_token"`
+
+		// RefreshToken new refresh token
+		RefreshToken string `json:"refresh_token"`
+
+		// ExpiresIn number of seconds the access token is valid
+		ExpiresIn int64 `json:"expires_in"`
+	}
+)
+
+// StaticToken token source always returning the same token
+func StaticToken(token string) TokenSource {
+	return staticToken(token)
+}
+
+// Token implementation
+func (t staticToken) Token(context.Context) (string, error) {
+	return string(t), nil
+}
+
+// NewRotatingTokenSource is rotating token source constructor. The onRefresh callback is called with every refreshed
+// token to persist it, can be nil. Its errors don't fail the refresh, they are passed to WithPersistErrorHook.
+// WithHttpClient, WithBaseUrl and WithMiddleware options are used for refresh requests
+func NewRotatingTokenSource(
+	clientID string,
+	clientSecret string,
+	token RotatingToken,
+	onRefresh func(ctx context.Context, token RotatingToken) error,
+	opts ...ClientOption,
+) *RotatingTokenSource {
+	c := &client{
+		baseUrl:    defaultBaseUrl,
+		httpClient: &http.Client{},
+	}
+
+	for _, opt := range opts {
+		opt.apply(c)
+	}
+
+	c.roundTrip = chain(c.middlewares, c.do)
+
+	return &RotatingTokenSource{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		token:        token,
+		onRefresh:    onRefresh,
+		client:       c,
+		now:          time.Now,
+	}
+}
+
+// Token implementation, refreshes the token if it expires in less than 5 minutes
+func (s *RotatingTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.ExpiresAt.IsZero() || s.now().Add(refreshMargin).Before(s.token.ExpiresAt) {
+		return s.token.AccessToken, nil
+	}
+
+	if err := s.refresh(ctx); err != nil {
+		return "", err
+	}
+
+	return s.token.AccessToken, nil
+}
+
+// Refresh implementation
+func (s *RotatingTokenSource) Refresh(ctx context.Context, expired string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.AccessToken != expired {
+		return s.token.AccessToken, nil
+	}
+
+	if err := s.refresh(ctx); err != nil {
+		return "", err
+	}
+
+	return s.token.AccessToken, nil
+}
+
+func (s *RotatingTokenSource) refresh(ctx context.Context) error {
+	params := url.Values{
+		"client_id":     {s.clientID},
+		"client_secret": {s.clientSecret},
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {s.token.RefreshToken},
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.client.baseUrl+"/oauth.v2.access", bytes.NewReader([]byte(params.Encode())))
+	if nil != err {
+		return fmt.Errorf("can't create http request: %w", err)
+	}
+
+	req = req.WithContext(ctx)
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+	var resp *http.Response
+	if resp, err = s.client.roundTrip(ctx, &Request{
+		Method:      "oauth.v2.access",
+		Params:      url.Values{},
+		Body:        []byte(params.Encode()),
+		Attempt:     1,
+		HTTPRequest: req,
+	}); nil != err {
+		return fmt.Errorf("can't send http request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if http.StatusOK != resp.StatusCode {
+		return fmt.Errorf("slack respond with %d status code", resp.StatusCode)
+	}
+
+	var body []byte
+	if body, err = ioutil.ReadAll(resp.Body); nil != err {
+		return fmt.Errorf("can't read response body: %w", err)
+	}
+
+	var refreshed refreshApiResponse
+	if err = json.Unmarshal(body, &refreshed); err != nil {
+		return fmt.Errorf("can't unmarshal response: %w", err)
+	}
+
+	if !refreshed.Ok {
+		return fmt.Errorf("slack respond with error: %s", refreshed.Error)
+	}
+
+	s.token = RotatingToken{
+		AccessToken:  refreshed.AccessToken,
+		RefreshToken: refreshed.RefreshToken,
+		ExpiresAt:    s.now().Add(time.Duration(refreshed.ExpiresIn) * time.Second),
+	}
+
+	// the refreshed token is used even if it can't be persisted, the previous refresh token is already revoked
+	if s.onRefresh == nil {
+		return nil
+	}
+
+	if err = s.onRefresh(ctx, s.token); err != nil && s.client.persistErrorHook != nil {
+		s.client.persistErrorHook(ctx, fmt.Errorf("can't persist refreshed token: %w", err))
+	}
+
+	return nil
+}
+
+func errorCode(body []byte) string {
+	var resp struct {
+		Ok    bool   `json:"ok"`
+		Error string `json:"error"`
+	}
+
+	if err := json.Unmarshal(body, &resp); err != nil || resp.Ok {
+		return ""
+	}
+
+	return resp.Error
+}
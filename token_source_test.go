@@ -0,0 +1,193 @@
+package slack_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-slack"
+)
+
+func TestStaticToken(t *testing.T) {
+	token, err := slack.StaticToken("xoxb-test").Token(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "xoxb-test", token)
+}
+
+func TestRotatingTokenSource_Token(t *testing.T) {
+	var (
+		baseUrl      = "http://test.slack.com/api"
+		refreshed    = `{"ok":true,"access_token":"xoxe.xoxb-new","refresh_token":"xoxe-new","expires_in":43200}`
+		refreshMatch = mock.MatchedBy(func(req *http.Request) bool {
+			return strings.HasSuffix(req.URL.Path, "oauth.v2.access")
+		})
+	)
+
+	t.Run("valid token", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+
+		source := slack.NewRotatingTokenSource("id", "secret", slack.RotatingToken{
+			AccessToken:  "xoxe.xoxb-old",
+			RefreshToken: "xoxe-old",
+			ExpiresAt:    time.Now().Add(time.Hour),
+		}, nil, slack.WithHttpClient(httpClient))
+
+		token, err := source.Token(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "xoxe.xoxb-old", token)
+		httpClient.AssertNotCalled(t, "Do", mock.Anything)
+	})
+
+	t.Run("expiring token is refreshed once", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", refreshMatch).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, baseUrl+"/"+"oauth.v2.access", req.URL.String())
+
+			body, err := ioutil.ReadAll(req.Body)
+			assert.NoError(t, err)
+
+			params, err := url.ParseQuery(string(body))
+			assert.NoError(t, err)
+			assert.Equal(t, "refresh_token", params.Get("grant_type"))
+			assert.Equal(t, "xoxe-old", params.Get("refresh_token"))
+			assert.Equal(t, "id", params.Get("client_id"))
+			assert.Equal(t, "secret", params.Get("client_secret"))
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(refreshed))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		var persisted []slack.RotatingToken
+		source := slack.NewRotatingTokenSource("id", "secret", slack.RotatingToken{
+			AccessToken:  "xoxe.xoxb-old",
+			RefreshToken: "xoxe-old",
+			ExpiresAt:    time.Now().Add(time.Minute),
+		}, func(ctx context.Context, token slack.RotatingToken) error {
+			persisted = append(persisted, token)
+			return nil
+		}, slack.WithBaseUrl(baseUrl), slack.WithHttpClient(httpClient))
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				token, err := source.Token(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, "xoxe.xoxb-new", token)
+			}()
+		}
+		wg.Wait()
+
+		httpClient.AssertNumberOfCalls(t, "Do", 1)
+		assert.Len(t, persisted, 1)
+		assert.Equal(t, "xoxe-new", persisted[0].RefreshToken)
+		assert.True(t, persisted[0].ExpiresAt.After(time.Now().Add(11*time.Hour)))
+	})
+
+	t.Run("error on persisting token", func(t *testing.T) {
+		expErr := errors.New("test error")
+
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", refreshMatch).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(refreshed))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		var persistErr error
+		source := slack.NewRotatingTokenSource("id", "secret", slack.RotatingToken{
+			AccessToken: "xoxe.xoxb-old",
+			ExpiresAt:   time.Now(),
+		}, func(ctx context.Context, token slack.RotatingToken) error {
+			return expErr
+		}, slack.WithHttpClient(httpClient), slack.WithPersistErrorHook(func(ctx context.Context, err error) {
+			persistErr = err
+		}))
+
+		token, err := source.Token(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "xoxe.xoxb-new", token)
+		assert.True(t, errors.Is(persistErr, expErr))
+
+		// the refreshed token is kept, it's not refreshed again
+		token, err = source.Token(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "xoxe.xoxb-new", token)
+		httpClient.AssertNumberOfCalls(t, "Do", 1)
+	})
+
+	t.Run("slack respond with error", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", refreshMatch).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":false,"error":"invalid_refresh_token"}`))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		source := slack.NewRotatingTokenSource("id", "secret", slack.RotatingToken{
+			AccessToken: "xoxe.xoxb-old",
+			ExpiresAt:   time.Now(),
+		}, nil, slack.WithHttpClient(httpClient))
+
+		_, err := source.Token(context.Background())
+		assert.Error(t, err)
+		assert.Equal(t, "slack respond with error: invalid_refresh_token", err.Error())
+	})
+}
+
+func TestClient_WithTokenSource(t *testing.T) {
+	baseUrl := "http://test.slack.com/api"
+
+	httpClient := new(slack.MockHTTPClient)
+	httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		return strings.HasSuffix(req.URL.Path, "oauth.v2.access")
+	})).Return(&http.Response{
+		Body: ioutil.NopCloser(bytes.NewReader([]byte(
+			`{"ok":true,"access_token":"xoxe.xoxb-new","refresh_token":"xoxe-new","expires_in":43200}`))),
+		StatusCode: http.StatusOK,
+	}, nil).Once()
+	httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Header.Get("Authorization") == "Bearer xoxe.xoxb-old"
+	})).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":false,"error":"token_expired"}`))),
+		StatusCode: http.StatusOK,
+	}, nil).Once()
+	httpClient.On("Do", mock.MatchedBy(func(req *http.Request) bool {
+		return req.Header.Get("Authorization") == "Bearer xoxe.xoxb-new"
+	})).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"channel":"C1","text":"hi"}`, string(body))
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"ok":true,"channel":"C1"}`))),
+		StatusCode: http.StatusOK,
+	}, nil).Once()
+
+	source := slack.NewRotatingTokenSource("id", "secret", slack.RotatingToken{
+		AccessToken:  "xoxe.xoxb-old",
+		RefreshToken: "xoxe-old",
+	}, nil, slack.WithBaseUrl(baseUrl), slack.WithHttpClient(httpClient))
+
+	c := slack.NewClient("", slack.WithTokenSource(source), slack.WithBaseUrl(baseUrl), slack.WithHttpClient(httpClient))
+
+	posted, err := c.PostMessage(context.Background(), "hi", "C1")
+	assert.NoError(t, err)
+	assert.Equal(t, "C1", posted.Channel)
+	httpClient.AssertExpectations(t)
+}
@@ -19,6 +19,27 @@ type (
 		User User `json:"user"`
 	}
 
+	// EnterpriseUser Enterprise Grid membership of a user
+	EnterpriseUser struct {
+		// ID global user identifier across the organization
+		ID string `json:"id"`
+
+		// EnterpriseID Enterprise Grid organization identifier
+		EnterpriseID string `json:"enterprise_id"`
+
+		// EnterpriseName Enterprise Grid organization name
+		EnterpriseName string `json:"enterprise_name"`
+
+		// IsAdmin the user is an admin of the organization
+		IsAdmin bool `json:"is_admin"`
+
+		// IsOwner the user is an owner of the organization
+		IsOwner bool `json:"is_owner"`
+
+		// Teams workspaces of the organization the user is a member of
+		Teams []string `json:"teams"`
+	}
+
 	// User entity
 	User struct {
 		// ID identifier for this workspace user. It is unique to the workspace containing the user. Use this field
@@ -28,6 +49,9 @@ type (
 		// TeamID
 		TeamID string `json:"team_id"`
 
+		// EnterpriseUser Enterprise Grid membership of the user, set only for organization members
+		EnterpriseUser *EnterpriseUser `json:"enterprise_user,omitempty"`
+
 		// Name deprecated field
 		Name string `json:"name"`
 
@@ -0,0 +1,225 @@
+// Package slack - views
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// ViewModal type of modal views
+	ViewModal = "modal"
+
+	// ViewHome type of App Home views
+	ViewHome = "home"
+
+	hashConflictError = "hash_conflict"
+)
+
+// ErrHashConflict the view was changed since the hash passed to update was received
+var ErrHashConflict = errors.New("slack respond with error: " + hashConflictError)
+
+type (
+	// View modal or App Home view
+	View struct {
+		// ID view identifier, set by slack
+		ID string `json:"id,omitempty"`
+
+		// TeamID workspace of the view, set by slack
+		TeamID string `json:"team_id,omitempty"`
+
+		// Type ViewModal or ViewHome
+		Type string `json:"type"`
+
+		// Title title of a modal
+		Title *TextObject `json:"title,omitempty"`
+
+		// Submit text of the submit button of a modal
+		Submit *TextObject `json:"submit,omitempty"`
+
+		// Close text of the close button of a modal
+		Close *TextObject `json:"close,omitempty"`
+
+		// Blocks layout blocks of the view
+		Blocks Blocks `json:"blocks"`
+
+		// PrivateMetadata string sent back to the app with interactions of the view
+		PrivateMetadata string `json:"private_metadata,omitempty"`
+
+		// CallbackID identifier to route interactions of the view
+		CallbackID string `json:"callback_id,omitempty"`
+
+		// ExternalID app defined unique identifier of the view
+		ExternalID string `json:"external_id,omitempty"`
+
+		// ClearOnClose close all views of the stack when the close button is clicked
+		ClearOnClose bool `json:"clear_on_close,omitempty"`
+
+		// NotifyOnClose send view_closed interaction when the view is closed
+		NotifyOnClose bool `json:"notify_on_close,omitempty"`
+
+		// State values of input blocks, set by slack
+		State *ViewState `json:"state,omitempty"`
+
+		// Hash unique value of the view version, set by slack
+		Hash string `json:"hash,omitempty"`
+
+		// RootViewID id of the root view of the stack, set by slack
+		RootViewID string `json:"root_view_id,omitempty"`
+
+		// PreviousViewID id of the previous view of the stack, set by slack
+		PreviousViewID string `json:"previous_view_id,omitempty"`
+
+		// AppID app the view belongs to, set by slack
+		AppID string `json:"app_id,omitempty"`
+
+		// BotID bot user of the app, set by slack
+		BotID string `json:"bot_id,omitempty"`
+	}
+
+	// ViewState state of input blocks of a view
+	ViewState struct {
+		// Values values of interactive elements by block_id and action_id
+		Values map[string]map[string]StateValue `json:"values"`
+	}
+
+	// StateValue value of an interactive element, the fields set depend on the element type
+	StateValue struct {
+		// Type type of the element, e.g. plain_text_input or static_select
+		Type string `json:"type"`
+
+		// Value text of a plain text input or value of a button
+		Value string `json:"value,omitempty"`
+
+		// SelectedOption chosen option of a select menu or radio buttons
+		SelectedOption *Option `json:"selected_option,omitempty"`
+
+		// SelectedOptions chosen options of a multi select menu or checkboxes
+		SelectedOptions []Option `json:"selected_options,omitempty"`
+
+		// SelectedUser chosen user of a users select
+		SelectedUser string `json:"selected_user,omitempty"`
+
+		// SelectedUsers chosen users of a multi users select
+		SelectedUsers []string `json:"selected_users,omitempty"`
+
+		// SelectedChannel chosen channel of a channels select
+		SelectedChannel string `json:"selected_channel,omitempty"`
+
+		// SelectedChannels chosen channels of a multi channels select
+		SelectedChannels []string `json:"selected_channels,omitempty"`
+
+		// SelectedConversation chosen conversation of a conversations select
+		SelectedConversation string `json:"selected_conversation,omitempty"`
+
+		// SelectedConversations chosen conversations of a multi conversations select
+		SelectedConversations []string `json:"selected_conversations,omitempty"`
+
+		// SelectedDate chosen date of a date picker, YYYY-MM-DD
+		SelectedDate string `json:"selected_date,omitempty"`
+
+		// SelectedTime chosen time of a time picker, HH:mm
+		SelectedTime string `json:"selected_time,omitempty"`
+	}
+
+	viewRequest struct {
+		TriggerID  string `json:"trigger_id,omitempty"`
+		UserID     string `json:"user_id,omitempty"`
+		ViewID     string `json:"view_id,omitempty"`
+		ExternalID string `json:"external_id,omitempty"`
+		Hash       string `json:"hash,omitempty"`
+		View       View   `json:"view"`
+	}
+
+	viewApiResponse struct {
+		// Ok indicates success or failure
+		Ok bool `json:"ok"`
+
+		// Error short machine-readable error code
+		Error string `json:"error"`
+
+		// View opened, pushed, updated or published view
+		View View `json:"view"`
+	}
+)
+
+// Value returns value of the element with the action_id in the block with the block_id
+func (s *ViewState) Value(blockID, actionID string) (StateValue, bool) {
+	if s == nil {
+		return StateValue{}, false
+	}
+
+	value, ok := s.Values[blockID][actionID]
+
+	return value, ok
+}
+
+// Date parses the selected date of a date picker
+func (v StateValue) Date() (time.Time, error) {
+	return time.Parse("2006-01-02", v.SelectedDate)
+}
+
+// Options returns values of the selected options of single or multi select menus
+func (v StateValue) Options() []string {
+	if v.SelectedOption != nil {
+		return []string{v.SelectedOption.Value}
+	}
+
+	values := make([]string, 0, len(v.SelectedOptions))
+	for _, option := range v.SelectedOptions {
+		values = append(values, option.Value)
+	}
+
+	return values
+}
+
+func openView(ctx context.Context, c *client, triggerID string, view View) (View, error) {
+	return sendViewRequest(ctx, c, "views.open", viewRequest{TriggerID: triggerID, View: view})
+}
+
+func pushView(ctx context.Context, c *client, triggerID string, view View) (View, error) {
+	return sendViewRequest(ctx, c, "views.push", viewRequest{TriggerID: triggerID, View: view})
+}
+
+func updateView(ctx context.Context, c *client, viewID, hash string, view View) (View, error) {
+	req := viewRequest{ViewID: viewID, Hash: hash, View: view}
+	if len(viewID) == 0 {
+		req.ExternalID = view.ExternalID
+	}
+
+	return sendViewRequest(ctx, c, "views.update", req)
+}
+
+func publishHomeView(ctx context.Context, c *client, userID, hash string, view View) (View, error) {
+	return sendViewRequest(ctx, c, "views.publish", viewRequest{UserID: userID, Hash: hash, View: view})
+}
+
+func sendViewRequest(ctx context.Context, c *client, method string, req viewRequest) (View, error) {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return View{}, fmt.Errorf("can't marshal request: %w", err)
+	}
+
+	respBody, err := c.post(ctx, method, data)
+	if err != nil {
+		return View{}, err
+	}
+
+	var resp viewApiResponse
+	if err = json.Unmarshal(respBody, &resp); err != nil {
+		return View{}, fmt.Errorf("can't unmarshal response: %w", err)
+	}
+
+	if resp.Error == hashConflictError {
+		return View{}, ErrHashConflict
+	}
+
+	if !resp.Ok {
+		return View{}, fmt.Errorf("slack respond with error: %s", resp.Error)
+	}
+
+	return resp.View, nil
+}
@@ -0,0 +1,156 @@
+package slack_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-slack"
+)
+
+func viewHttpClient(t *testing.T, url, request, response string) *slack.MockHTTPClient {
+	httpClient := new(slack.MockHTTPClient)
+	httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+		req, ok := args.Get(0).(*http.Request)
+		assert.True(t, ok)
+
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, url, req.URL.String())
+
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, request, string(body))
+	}).Return(&http.Response{
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(response))),
+		StatusCode: http.StatusOK,
+	}, nil)
+
+	return httpClient
+}
+
+func TestClient_OpenView(t *testing.T) {
+	baseUrl := "http://test.slack.com/api"
+
+	httpClient := viewHttpClient(t, baseUrl+"/views.open",
+		`{"trigger_id":"tr1","view":{"type":"modal","title":{"type":"plain_text","text":"Feedback"},`+
+			`"submit":{"type":"plain_text","text":"Send"},"callback_id":"feedback","blocks":[`+
+			`{"type":"input","block_id":"comment","label":{"type":"plain_text","text":"Comment"},`+
+			`"element":{"type":"plain_text_input","action_id":"text","multiline":true}},`+
+			`{"type":"divider"}]}}`,
+		`{"ok":true,"view":{"id":"V1","type":"modal","callback_id":"feedback","hash":"h1","blocks":[`+
+			`{"type":"divider","block_id":"d1"},{"type":"image","image_url":"https://example.com/a.png"}]}}`,
+	)
+
+	c := slack.NewClient("test_token", slack.WithBaseUrl(baseUrl), slack.WithHttpClient(httpClient))
+
+	input := slack.NewPlainTextInputElement("text")
+	input.Multiline = true
+
+	view, err := c.OpenView(context.Background(), "tr1", slack.View{
+		Type:       slack.ViewModal,
+		Title:      slack.PlainText("Feedback"),
+		Submit:     slack.PlainText("Send"),
+		CallbackID: "feedback",
+		Blocks: slack.Blocks{
+			slack.NewInputBlock("comment", "Comment", input),
+			slack.NewDividerBlock(),
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "V1", view.ID)
+	assert.Equal(t, "h1", view.Hash)
+	assert.Equal(t, slack.Blocks{
+		&slack.DividerBlock{Type: slack.BlockDivider, BlockID: "d1"},
+		&slack.RawBlock{Type: "image", Data: json.RawMessage(`{"type":"image","image_url":"https://example.com/a.png"}`)},
+	}, view.Blocks)
+}
+
+func TestClient_PushView(t *testing.T) {
+	httpClient := viewHttpClient(t, "http://test.slack.com/api/views.push",
+		`{"trigger_id":"tr1","view":{"type":"modal","blocks":[]}}`,
+		`{"ok":true,"view":{"id":"V2","type":"modal","root_view_id":"V1","previous_view_id":"V1"}}`,
+	)
+
+	c := slack.NewClient("test_token", slack.WithBaseUrl("http://test.slack.com/api"), slack.WithHttpClient(httpClient))
+
+	view, err := c.PushView(context.Background(), "tr1", slack.View{Type: slack.ViewModal})
+	assert.NoError(t, err)
+	assert.Equal(t, "V1", view.RootViewID)
+}
+
+func TestClient_UpdateView(t *testing.T) {
+	t.Run("by external id", func(t *testing.T) {
+		httpClient := viewHttpClient(t, "http://test.slack.com/api/views.update",
+			`{"external_id":"ext1","hash":"h1","view":{"type":"modal","external_id":"ext1","blocks":[]}}`,
+			`{"ok":true,"view":{"id":"V1","type":"modal","hash":"h2"}}`,
+		)
+
+		c := slack.NewClient("test_token", slack.WithBaseUrl("http://test.slack.com/api"), slack.WithHttpClient(httpClient))
+
+		view, err := c.UpdateView(context.Background(), "", "h1", slack.View{Type: slack.ViewModal, ExternalID: "ext1"})
+		assert.NoError(t, err)
+		assert.Equal(t, "h2", view.Hash)
+	})
+
+	t.Run("hash conflict", func(t *testing.T) {
+		httpClient := viewHttpClient(t, "http://test.slack.com/api/views.update",
+			`{"view_id":"V1","hash":"old","view":{"type":"modal","blocks":[]}}`,
+			`{"ok":false,"error":"hash_conflict"}`,
+		)
+
+		c := slack.NewClient("test_token", slack.WithBaseUrl("http://test.slack.com/api"), slack.WithHttpClient(httpClient))
+
+		_, err := c.UpdateView(context.Background(), "V1", "old", slack.View{Type: slack.ViewModal})
+		assert.Equal(t, slack.ErrHashConflict, err)
+	})
+}
+
+func TestClient_PublishHomeView(t *testing.T) {
+	httpClient := viewHttpClient(t, "http://test.slack.com/api/views.publish",
+		`{"user_id":"U1","view":{"type":"home","blocks":[{"type":"header","text":{"type":"plain_text","text":"Hi"}}]}}`,
+		`{"ok":false,"error":"not_enabled"}`,
+	)
+
+	c := slack.NewClient("test_token", slack.WithBaseUrl("http://test.slack.com/api"), slack.WithHttpClient(httpClient))
+
+	_, err := c.PublishHomeView(context.Background(), "U1", "", slack.View{
+		Type:   slack.ViewHome,
+		Blocks: slack.Blocks{slack.NewHeaderBlock("Hi")},
+	})
+	assert.Error(t, err)
+	assert.Equal(t, "slack respond with error: not_enabled", err.Error())
+}
+
+func TestViewState_Value(t *testing.T) {
+	var view slack.View
+	assert.NoError(t, json.Unmarshal([]byte(`{"type":"modal","state":{"values":{`+
+		`"due":{"date":{"type":"datepicker","selected_date":"2021-03-04"}},`+
+		`"tags":{"select":{"type":"multi_static_select","selected_options":[`+
+		`{"text":{"type":"plain_text","text":"A"},"value":"a"},{"text":{"type":"plain_text","text":"B"},"value":"b"}]}},`+
+		`"comment":{"text":{"type":"plain_text_input","value":"looks good"}}}}}`), &view))
+
+	comment, ok := view.State.Value("comment", "text")
+	assert.True(t, ok)
+	assert.Equal(t, "looks good", comment.Value)
+
+	tags, ok := view.State.Value("tags", "select")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"a", "b"}, tags.Options())
+
+	due, ok := view.State.Value("due", "date")
+	assert.True(t, ok)
+
+	date, err := due.Date()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC), date)
+
+	_, ok = view.State.Value("comment", "missing")
+	assert.False(t, ok)
+}
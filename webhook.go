@@ -0,0 +1,196 @@
+// Package slack - webhooks
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	defaultWebhookRetries = 3
+	defaultWebhookBackoff = time.Second
+)
+
+// ErrInvalidWebhookURL the url is not a slack hooks url
+var ErrInvalidWebhookURL = errors.New("url is not a slack hooks url")
+
+var webhookHosts = map[string]bool{
+	"hooks.slack.com":     true,
+	"hooks.slack-gov.com": true,
+}
+
+type (
+	// WebhookClient sends messages to incoming webhooks and response urls of interactions and slash commands.
+	// These urls don't require a token
+	WebhookClient interface {
+		// Send posts the message to the hook url
+		Send(ctx context.Context, hookURL string, msg WebhookMessage) error
+	}
+
+	// WebhookMessage message sent to a hook url
+	WebhookMessage struct {
+		Message
+
+		// Blocks layout blocks of the message
+		Blocks Blocks `json:"blocks,omitempty"`
+
+		// ResponseType ephemeral or in_channel, used only for response urls
+		ResponseType string `json:"response_type,omitempty"`
+
+		// ReplaceOriginal replace the message the interaction originated from
+		ReplaceOriginal bool `json:"replace_original,omitempty"`
+
+		// DeleteOriginal delete the message the interaction originated from
+		DeleteOriginal bool `json:"delete_original,omitempty"`
+	}
+
+	webhookClient struct {
+		httpClient HTTPClient
+		retries    int
+		backoff    time.Duration
+	}
+
+	webhookStatusError struct {
+		statusCode int
+		body       string
+		retryAfter time.Duration
+	}
+)
+
+// NewWebhookClient is webhook client constructor
+func NewWebhookClient(opts ...WebhookOption) WebhookClient {
+	c := &webhookClient{
+		httpClient: &http.Client{},
+		retries:    defaultWebhookRetries,
+		backoff:    defaultWebhookBackoff,
+	}
+
+	for _, opt := range opts {
+		opt.apply(c)
+	}
+
+	return c
+}
+
+// Send implementation. Server errors are retried with exponential backoff, rate limited requests are retried after
+// the delay from the Retry-After header
+func (c *webhookClient) Send(ctx context.Context, hookURL string, msg WebhookMessage) error {
+	u, err := validateWebhookURL(hookURL)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("can't marshal request: %w", err)
+	}
+
+	backoff := c.backoff
+	for attempt := 0; ; attempt++ {
+		err = c.send(ctx, u, data)
+
+		var statusErr *webhookStatusError
+		if !errors.As(err, &statusErr) || !statusErr.temporary() || attempt >= c.retries {
+			return err
+		}
+
+		delay := backoff
+		if statusErr.statusCode == http.StatusTooManyRequests {
+			delay = statusErr.retryAfter
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		backoff *= 2
+	}
+}
+
+func (c *webhookClient) send(ctx context.Context, u *url.URL, data []byte) error {
+	req, err := http.NewRequest(http.MethodPost, u.String(), bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("can't create http request: %w", err)
+	}
+
+	req = req.WithContext(ctx)
+	req.Header.Add("Content-Type", "application/json; charset=utf-8")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		// transport errors contain the url, the path of hook urls is a secret
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			urlErr.URL = redactWebhookURL(u)
+		}
+
+		return fmt.Errorf("can't send http request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if http.StatusOK != resp.StatusCode {
+		body, _ := ioutil.ReadAll(resp.Body)
+
+		statusErr := &webhookStatusError{statusCode: resp.StatusCode, body: string(body)}
+		if resp.StatusCode == http.StatusTooManyRequests {
+			statusErr.retryAfter = retryAfter(resp.Header)
+		}
+
+		return statusErr
+	}
+
+	return nil
+}
+
+func (e *webhookStatusError) Error() string {
+	if len(e.body) == 0 {
+		return fmt.Sprintf("slack respond with %d status code", e.statusCode)
+	}
+
+	return fmt.Sprintf("slack respond with %d status code: %s", e.statusCode, e.body)
+}
+
+func (e *webhookStatusError) temporary() bool {
+	return e.statusCode == http.StatusTooManyRequests || e.statusCode >= http.StatusInternalServerError
+}
+
+// validateWebhookURL parses the hook url, errors don't contain its secret path
+func validateWebhookURL(hookURL string) (*url.URL, error) {
+	u, err := url.Parse(hookURL)
+	if err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+
+		return nil, fmt.Errorf("can't parse url: %w", err)
+	}
+
+	if u.Scheme != "https" || !webhookHosts[u.Hostname()] {
+		return nil, fmt.Errorf("%s: %w", redactWebhookURL(u), ErrInvalidWebhookURL)
+	}
+
+	return u, nil
+}
+
+// redactWebhookURL returns the host and the first path segment of the url, e.g. hooks.slack.com/services/…
+func redactWebhookURL(u *url.URL) string {
+	segments := strings.SplitN(strings.TrimPrefix(u.Path, "/"), "/", 2)
+	if len(segments) < 2 {
+		return u.Host
+	}
+
+	return u.Host + "/" + segments[0] + "/…"
+}
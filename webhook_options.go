@@ -0,0 +1,40 @@
+// Package slack - webhook client options
+package slack
+
+import "time"
+
+type (
+	// WebhookOption to use optional parameters in webhook client
+	WebhookOption interface {
+		apply(c *webhookClient)
+	}
+
+	withWebhookHttpClient struct {
+		httpClient HTTPClient
+	}
+
+	withWebhookRetries struct {
+		retries int
+		backoff time.Duration
+	}
+)
+
+// WithWebhookHttpClient replaces default http client of webhook client
+func WithWebhookHttpClient(httpClient HTTPClient) WebhookOption {
+	return &withWebhookHttpClient{httpClient: httpClient}
+}
+
+func (opt *withWebhookHttpClient) apply(c *webhookClient) {
+	c.httpClient = opt.httpClient
+}
+
+// WithWebhookRetries sets number of retries of server errors and rate limited requests and delay before the first
+// retry of server errors, doubled for each next one. 3 retries starting with 1 second by default
+func WithWebhookRetries(retries int, backoff time.Duration) WebhookOption {
+	return &withWebhookRetries{retries: retries, backoff: backoff}
+}
+
+func (opt *withWebhookRetries) apply(c *webhookClient) {
+	c.retries = opt.retries
+	c.backoff = opt.backoff
+}
@@ -0,0 +1,154 @@
+package slack_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kryabinin/go-slack"
+)
+
+func TestWebhookClient_Send(t *testing.T) {
+	hookURL := "https://hooks.slack.com/services/T1/B1/secret"
+
+	t.Run("positive case", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, hookURL, req.URL.String())
+			assert.Empty(t, req.Header.Get("Authorization"))
+
+			request, err := ioutil.ReadAll(req.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"text":"updated","response_type":"in_channel","replace_original":true}`,
+				string(request))
+		}).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("ok"))),
+			StatusCode: http.StatusOK,
+		}, nil)
+
+		c := slack.NewWebhookClient(slack.WithWebhookHttpClient(httpClient))
+
+		assert.NoError(t, c.Send(context.Background(), hookURL, slack.WebhookMessage{
+			Message:         slack.Message{Text: "updated"},
+			ResponseType:    "in_channel",
+			ReplaceOriginal: true,
+		}))
+	})
+
+	t.Run("invalid url", func(t *testing.T) {
+		c := slack.NewWebhookClient(slack.WithWebhookHttpClient(new(slack.MockHTTPClient)))
+
+		for _, u := range []string{"http://hooks.slack.com/services/1/secret", "https://example.com/services/1/secret"} {
+			err := c.Send(context.Background(), u, slack.WebhookMessage{DeleteOriginal: true})
+			assert.True(t, errors.Is(err, slack.ErrInvalidWebhookURL))
+			assert.NotContains(t, err.Error(), "secret")
+		}
+	})
+
+	t.Run("transport error doesn't contain the url path", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(nil, &url.Error{
+			Op:  "Post",
+			URL: hookURL,
+			Err: errors.New("connection refused"),
+		})
+
+		c := slack.NewWebhookClient(slack.WithWebhookHttpClient(httpClient))
+
+		err := c.Send(context.Background(), hookURL, slack.WebhookMessage{})
+		assert.Error(t, err)
+		assert.Equal(t,
+			`can't send http request: Post "hooks.slack.com/services/…": connection refused`, err.Error())
+	})
+
+	t.Run("rate limited request waits for retry after", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Header:     http.Header{"Retry-After": {"30"}},
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+			StatusCode: http.StatusTooManyRequests,
+		}, nil)
+
+		c := slack.NewWebhookClient(
+			slack.WithWebhookHttpClient(httpClient),
+			slack.WithWebhookRetries(3, time.Millisecond),
+		)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		assert.True(t, errors.Is(c.Send(ctx, hookURL, slack.WebhookMessage{}), context.DeadlineExceeded))
+		httpClient.AssertNumberOfCalls(t, "Do", 1)
+	})
+
+	t.Run("retry rate limited request", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Header:     http.Header{"Retry-After": {"1"}},
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+			StatusCode: http.StatusTooManyRequests,
+		}, nil).Once()
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("ok"))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		c := slack.NewWebhookClient(
+			slack.WithWebhookHttpClient(httpClient),
+			slack.WithWebhookRetries(1, time.Millisecond),
+		)
+
+		assert.NoError(t, c.Send(context.Background(), hookURL, slack.WebhookMessage{}))
+		httpClient.AssertNumberOfCalls(t, "Do", 2)
+	})
+
+	t.Run("retry on server error", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+			StatusCode: http.StatusServiceUnavailable,
+		}, nil).Once()
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("ok"))),
+			StatusCode: http.StatusOK,
+		}, nil).Once()
+
+		c := slack.NewWebhookClient(
+			slack.WithWebhookHttpClient(httpClient),
+			slack.WithWebhookRetries(1, time.Millisecond),
+		)
+
+		assert.NoError(t, c.Send(context.Background(), hookURL, slack.WebhookMessage{}))
+		httpClient.AssertNumberOfCalls(t, "Do", 2)
+	})
+
+	t.Run("no retry on client error", func(t *testing.T) {
+		httpClient := new(slack.MockHTTPClient)
+		httpClient.On("Do", mock.AnythingOfType("*http.Request")).Return(&http.Response{
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("invalid_payload"))),
+			StatusCode: http.StatusBadRequest,
+		}, nil)
+
+		c := slack.NewWebhookClient(
+			slack.WithWebhookHttpClient(httpClient),
+			slack.WithWebhookRetries(3, time.Millisecond),
+		)
+
+		err := c.Send(context.Background(), hookURL, slack.WebhookMessage{})
+		assert.Error(t, err)
+		assert.Equal(t, "slack respond with 400 status code: invalid_payload", err.Error())
+		httpClient.AssertNumberOfCalls(t, "Do", 1)
+	})
+}